@@ -74,11 +74,14 @@ func main() {
 	// Bağımlılıkları oluştur (DI - Dependency Injection)
 	userStore := store.NewUserStore(db.DB)
 	deviceStore := store.NewUserDeviceStore(db.DB)
-	authService := service.NewAuthService(userStore, deviceStore)
+	contactStore := store.NewContactStore(db.DB)
+	authService := service.NewAuthService(userStore, deviceStore, contactStore)
 	authHandler := handler.NewAuthHandler(authService)
+	userHandler := handler.NewUserHandler(service.NewProfileService(userStore, store.NewBlockStore(db.DB), contactStore, store.NewPrivacyStore(db.DB)))
 
 	// Handler'ı gRPC sunucusuna kaydet
 	authHandler.Register(s)
+	userHandler.Register(s)
 
 	log.Printf("auth_service listening on %s (env=%s)", listenAddr, os.Getenv("APP_ENV"))
 	if err := s.Serve(lis); err != nil {
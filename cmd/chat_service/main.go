@@ -1,7 +1,108 @@
 package main
 
-import "fmt"
+import (
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/dykethecreator/GoApp/internal/auth/middleware"
+	"github.com/dykethecreator/GoApp/internal/chat/handler"
+	"github.com/dykethecreator/GoApp/internal/chat/service"
+	"github.com/dykethecreator/GoApp/internal/chat/store"
+	"github.com/dykethecreator/GoApp/pkg/database"
+	appjwt "github.com/dykethecreator/GoApp/pkg/jwt"
+	"github.com/joho/godotenv"
+	"google.golang.org/grpc"
+)
 
 func main() {
-	fmt.Println("Starting Chat Service...")
+	// Same environment loading strategy as auth_service:
+	// .env.{APP_ENV} (docker/local by default), then base .env for overrides.
+	appEnv := os.Getenv("APP_ENV")
+	if appEnv == "" {
+		if os.Getenv("RUNNING_IN_DOCKER") != "" {
+			appEnv = "docker"
+		} else {
+			appEnv = "local"
+		}
+	}
+	_ = godotenv.Load(".env." + appEnv)
+	_ = godotenv.Load()
+
+	db, err := database.NewDB(os.Getenv("DATABASE_URL"))
+	if err != nil {
+		log.Fatalf("failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	// Determine gRPC port (default 50052)
+	grpcPort := os.Getenv("CHAT_SERVICE_GRPC_PORT")
+	if grpcPort == "" {
+		grpcPort = "50052"
+	}
+	listenAddr := fmt.Sprintf(":%s", grpcPort)
+
+	lis, err := net.Listen("tcp", listenAddr)
+	if err != nil {
+		log.Fatalf("failed to listen: %v", err)
+	}
+
+	// Access tokens are issued by auth_service; validate them with the shared secret.
+	jwtSecret := os.Getenv("JWT_SECRET")
+	if jwtSecret == "" {
+		log.Fatal("JWT_SECRET not set")
+	}
+	tm, err := appjwt.NewTokenManager(jwtSecret, 15*time.Minute, 7*24*time.Hour)
+	if err != nil {
+		log.Fatalf("failed to init token manager: %v", err)
+	}
+
+	s := grpc.NewServer(
+		grpc.UnaryInterceptor(middleware.UnaryAuthInterceptor(tm)),
+	)
+
+	cfg := service.DefaultConfig()
+	if v := os.Getenv("CHAT_EDIT_WINDOW"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			log.Fatalf("invalid CHAT_EDIT_WINDOW %q: %v", v, err)
+		}
+		cfg.EditWindow = d
+	}
+	cfg.InviteLinkBaseURL = os.Getenv("CHAT_INVITE_LINK_BASE_URL")
+	for name, dst := range map[string]*int{
+		"CHAT_FORWARD_MAX_CHATS":          &cfg.MaxForwardChats,
+		"CHAT_FREQUENT_FORWARD_MAX_CHATS": &cfg.FrequentlyForwardedMaxChats,
+	} {
+		if v := os.Getenv(name); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil || n < 1 {
+				log.Fatalf("invalid %s %q: must be a positive integer", name, v)
+			}
+			*dst = n
+		}
+	}
+
+	chatService := service.NewChatService(service.Repositories{
+		Chats:     store.NewChatStore(db.DB),
+		Messages:  store.NewMessageStore(db.DB, os.Getenv("CHAT_SEARCH_LANGUAGE")),
+		Invites:   store.NewInviteStore(db.DB),
+		Blocks:    store.NewBlockStore(db.DB),
+		Receipts:  store.NewReceiptStore(db.DB),
+		Reactions: store.NewReactionStore(db.DB),
+		Pins:      store.NewPinStore(db.DB),
+		Polls:     store.NewPollStore(db.DB, os.Getenv("CHAT_SEARCH_LANGUAGE")),
+		Calls:     store.NewCallStore(db.DB),
+		Privacy:   store.NewPrivacyStore(db.DB),
+	}, cfg)
+	chatHandler := handler.NewChatHandler(chatService)
+	chatHandler.Register(s)
+
+	log.Printf("chat_service listening on %s (env=%s)", listenAddr, appEnv)
+	if err := s.Serve(lis); err != nil {
+		log.Fatalf("failed to serve: %v", err)
+	}
 }
@@ -1,7 +1,99 @@
 package main
 
-import "fmt"
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/dykethecreator/GoApp/internal/chat/store"
+	"github.com/dykethecreator/GoApp/internal/outbox"
+	realtimestore "github.com/dykethecreator/GoApp/internal/realtime/store"
+	statusstore "github.com/dykethecreator/GoApp/internal/status/store"
+	"github.com/dykethecreator/GoApp/internal/worker"
+	"github.com/dykethecreator/GoApp/pkg/config"
+	"github.com/dykethecreator/GoApp/pkg/database"
+	"github.com/dykethecreator/GoApp/pkg/eventbus"
+	"github.com/joho/godotenv"
+)
 
 func main() {
-	fmt.Println("Starting Message Worker...")
+	log.Println("Starting Message Worker...")
+
+	// Same environment loading strategy as auth_service:
+	// .env.{APP_ENV} (docker/local by default), then base .env for overrides.
+	appEnv := os.Getenv("APP_ENV")
+	if appEnv == "" {
+		if os.Getenv("RUNNING_IN_DOCKER") != "" {
+			appEnv = "docker"
+		} else {
+			appEnv = "local"
+		}
+	}
+	_ = godotenv.Load(".env." + appEnv)
+	_ = godotenv.Load()
+
+	db, err := database.NewDB(os.Getenv("DATABASE_URL"))
+	if err != nil {
+		log.Fatalf("failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	interval := config.EnvDuration("MESSAGE_SWEEP_INTERVAL", time.Minute)
+	statusInterval := config.EnvDuration("STATUS_SWEEP_INTERVAL", time.Minute)
+	batchSize := config.EnvInt("MESSAGE_SWEEP_BATCH_SIZE", 500)
+	statusBatchSize := config.EnvInt("STATUS_SWEEP_BATCH_SIZE", 500)
+
+	syncRetention := config.EnvDuration("SYNC_RETENTION", 30*24*time.Hour)
+	syncPruneInterval := config.EnvDuration("SYNC_PRUNE_INTERVAL", time.Hour)
+	syncBatchSize := config.EnvInt("SYNC_PRUNE_BATCH_SIZE", 500)
+
+	relayInterval := config.EnvDuration("OUTBOX_RELAY_INTERVAL", time.Second)
+	retention := config.EnvDuration("OUTBOX_RETENTION", 24*time.Hour)
+	relayBatchSize := config.EnvInt("OUTBOX_BATCH_SIZE", 100)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	messageStore := store.NewMessageStore(db.DB, os.Getenv("CHAT_SEARCH_LANGUAGE"))
+	sweeper := worker.NewMessageSweeper(messageStore, interval, batchSize)
+	statusSweeper := worker.NewStatusSweeper(statusstore.NewStatusStore(db.DB), statusInterval, statusBatchSize)
+	log.Printf("Sweeping expired messages every %s in batches of %d and expired statuses every %s in batches of %d", interval, batchSize, statusInterval, statusBatchSize)
+
+	syncStore := realtimestore.NewSyncStore(db.DB, messageStore)
+	syncPruner := worker.NewBatchSweeper("sync events", func(ctx context.Context, limit int) (int, error) {
+		return syncStore.Prune(ctx, time.Now().Add(-syncRetention), limit)
+	}, syncPruneInterval, syncBatchSize)
+	log.Printf("Pruning sync events every %s in batches of %d once every device passed them or after %s", syncPruneInterval, syncBatchSize, syncRetention)
+
+	runs := []func(context.Context){sweeper.Run, statusSweeper.Run, syncPruner.Run}
+
+	// The outbox relay needs a bus shared with the consuming services; this worker is the
+	// only one relaying, so without Kafka events stay in the outbox until it is configured.
+	if brokers := config.EnvList("KAFKA_BROKERS"); len(brokers) > 0 {
+		publisher, err := eventbus.NewKafkaPublisher(eventbus.KafkaConfig{Brokers: brokers})
+		if err != nil {
+			log.Fatalf("failed to create Kafka publisher: %v", err)
+		}
+		defer publisher.Close()
+		relay := worker.NewOutboxRelay(outbox.NewStore(db.DB), publisher, relayInterval, relayBatchSize, retention)
+		runs = append(runs, relay.Run)
+		log.Printf("Relaying outbox events every %s in batches of %d, keeping sent ones for %s", relayInterval, relayBatchSize, retention)
+	} else {
+		log.Println("KAFKA_BROKERS not set: not relaying the outbox")
+	}
+
+	var wg sync.WaitGroup
+	for _, run := range runs {
+		wg.Add(1)
+		go func(run func(context.Context)) {
+			defer wg.Done()
+			run(ctx)
+		}(run)
+	}
+	wg.Wait()
+	log.Println("Message Worker stopped")
 }
@@ -1,7 +1,152 @@
 package main
 
-import "fmt"
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	chatstore "github.com/dykethecreator/GoApp/internal/chat/store"
+	"github.com/dykethecreator/GoApp/internal/realtime"
+	"github.com/dykethecreator/GoApp/internal/realtime/store"
+	"github.com/dykethecreator/GoApp/pkg/config"
+	"github.com/dykethecreator/GoApp/pkg/database"
+	"github.com/dykethecreator/GoApp/pkg/eventbus"
+	appjwt "github.com/dykethecreator/GoApp/pkg/jwt"
+	"github.com/joho/godotenv"
+)
 
 func main() {
-	fmt.Println("Starting Realtime Service...")
+	log.Println("Starting Realtime Service...")
+
+	// Same environment loading strategy as auth_service:
+	// .env.{APP_ENV} (docker/local by default), then base .env for overrides.
+	appEnv := os.Getenv("APP_ENV")
+	if appEnv == "" {
+		if os.Getenv("RUNNING_IN_DOCKER") != "" {
+			appEnv = "docker"
+		} else {
+			appEnv = "local"
+		}
+	}
+	_ = godotenv.Load(".env." + appEnv)
+	_ = godotenv.Load()
+
+	db, err := database.NewDB(os.Getenv("DATABASE_URL"))
+	if err != nil {
+		log.Fatalf("failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	// Determine HTTP port (default 8081)
+	port := os.Getenv("REALTIME_SERVICE_PORT")
+	if port == "" {
+		port = "8081"
+	}
+
+	// Access tokens are issued by auth_service; validate them with the shared secret.
+	jwtSecret := os.Getenv("JWT_SECRET")
+	if jwtSecret == "" {
+		log.Fatal("JWT_SECRET not set")
+	}
+	tm, err := appjwt.NewTokenManager(jwtSecret, 15*time.Minute, 7*24*time.Hour)
+	if err != nil {
+		log.Fatalf("failed to init token manager: %v", err)
+	}
+
+	origins := config.EnvList("REALTIME_ALLOWED_ORIGINS")
+
+	cfg := realtime.DefaultConfig()
+	cfg.TypingTTL = config.EnvDuration("REALTIME_TYPING_TTL", cfg.TypingTTL)
+	hub := realtime.NewHub(realtime.Repositories{
+		Members: store.NewMemberStore(db.DB),
+		Users:   store.NewUserStore(db.DB),
+		Privacy: store.NewPrivacyStore(db.DB),
+		Sync:    store.NewSyncStore(db.DB, chatstore.NewMessageStore(db.DB, os.Getenv("CHAT_SEARCH_LANGUAGE"))),
+	}, cfg)
+
+	// Nodes share the connection registry and route frames to each other over the event
+	// bus. The in-memory bus and registry only span this process: run several nodes with
+	// KAFKA_BROKERS and REALTIME_REGISTRY=postgres. The node ID names the node's Kafka
+	// consumer group, so it must stay the same across restarts.
+	nodeID := os.Getenv("REALTIME_NODE_ID")
+	if nodeID == "" {
+		log.Fatal("REALTIME_NODE_ID not set: give every node a stable ID of its own")
+	}
+	const heartbeatInterval = 15 * time.Second
+	conns := store.NewMemoryConnectionStore()
+	sharedRegistry := false
+	switch v := os.Getenv("REALTIME_REGISTRY"); v {
+	case "", "memory":
+	case "postgres":
+		conns = store.NewConnectionStore(db.DB, 3*heartbeatInterval)
+		sharedRegistry = true
+	default:
+		log.Fatalf("invalid REALTIME_REGISTRY %q: must be memory or postgres", v)
+	}
+	// Every node consumes in a group of its own: it needs every chat and presence event.
+	var (
+		pub eventbus.Publisher
+		sub eventbus.Subscriber
+	)
+	if brokers := config.EnvList("KAFKA_BROKERS"); len(brokers) > 0 {
+		if !sharedRegistry {
+			log.Fatal("KAFKA_BROKERS needs REALTIME_REGISTRY=postgres: the in-memory registry cannot see the other nodes' connections")
+		}
+		kcfg := eventbus.KafkaConfig{Brokers: brokers, GroupID: "realtime." + nodeID, StartAtLatest: true}
+		kp, err := eventbus.NewKafkaPublisher(kcfg)
+		if err != nil {
+			log.Fatalf("failed to create Kafka publisher: %v", err)
+		}
+		defer kp.Close()
+		ks, err := eventbus.NewKafkaSubscriber(kcfg)
+		if err != nil {
+			log.Fatalf("failed to create Kafka subscriber: %v", err)
+		}
+		defer ks.Close()
+		pub, sub = kp, ks
+	} else {
+		// The in-memory bus only reaches this process, so the node serves alone and gets no
+		// chat events: message_worker relays the outbox to Kafka only. Devices still catch
+		// up through sync.
+		if sharedRegistry {
+			log.Fatal("REALTIME_REGISTRY=postgres needs KAFKA_BROKERS: the in-memory bus cannot reach other nodes")
+		}
+		bus := eventbus.NewMemoryBus()
+		defer bus.Close()
+		pub, sub = bus, bus.Group(nodeID)
+		log.Println("KAFKA_BROKERS not set: serving a single node without live chat events")
+	}
+	cluster, err := realtime.NewCluster(nodeID, hub, conns, pub, sub)
+	if err != nil {
+		log.Fatalf("failed to join the realtime cluster: %v", err)
+	}
+	if err := hub.Consume(sub); err != nil {
+		log.Fatalf("failed to subscribe to chat events: %v", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/ws", realtime.NewServer(hub, tm, origins))
+	srv := &http.Server{Addr: ":" + port, Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go cluster.Run(ctx, heartbeatInterval)
+	go func() {
+		<-ctx.Done()
+		hub.Close()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		_ = srv.Shutdown(shutdownCtx)
+	}()
+
+	log.Printf("realtime_service node %s listening on :%s (env=%s)", nodeID, port, appEnv)
+	if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Fatalf("failed to serve: %v", err)
+	}
+	log.Println("Realtime Service stopped")
 }
@@ -1,7 +1,94 @@
 package main
 
-import "fmt"
+import (
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"time"
+
+	"github.com/dykethecreator/GoApp/internal/auth/middleware"
+	chatservice "github.com/dykethecreator/GoApp/internal/chat/service"
+	chatstore "github.com/dykethecreator/GoApp/internal/chat/store"
+	"github.com/dykethecreator/GoApp/internal/status/handler"
+	"github.com/dykethecreator/GoApp/internal/status/service"
+	"github.com/dykethecreator/GoApp/internal/status/store"
+	"github.com/dykethecreator/GoApp/pkg/database"
+	appjwt "github.com/dykethecreator/GoApp/pkg/jwt"
+	"github.com/joho/godotenv"
+	"google.golang.org/grpc"
+)
 
 func main() {
-	fmt.Println("Starting Status Service...")
+	// Same environment loading strategy as auth_service:
+	// .env.{APP_ENV} (docker/local by default), then base .env for overrides.
+	appEnv := os.Getenv("APP_ENV")
+	if appEnv == "" {
+		if os.Getenv("RUNNING_IN_DOCKER") != "" {
+			appEnv = "docker"
+		} else {
+			appEnv = "local"
+		}
+	}
+	_ = godotenv.Load(".env." + appEnv)
+	_ = godotenv.Load()
+
+	db, err := database.NewDB(os.Getenv("DATABASE_URL"))
+	if err != nil {
+		log.Fatalf("failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	// Determine gRPC port (default 50053)
+	grpcPort := os.Getenv("STATUS_SERVICE_GRPC_PORT")
+	if grpcPort == "" {
+		grpcPort = "50053"
+	}
+	listenAddr := fmt.Sprintf(":%s", grpcPort)
+
+	lis, err := net.Listen("tcp", listenAddr)
+	if err != nil {
+		log.Fatalf("failed to listen: %v", err)
+	}
+
+	// Access tokens are issued by auth_service; validate them with the shared secret.
+	jwtSecret := os.Getenv("JWT_SECRET")
+	if jwtSecret == "" {
+		log.Fatal("JWT_SECRET not set")
+	}
+	tm, err := appjwt.NewTokenManager(jwtSecret, 15*time.Minute, 7*24*time.Hour)
+	if err != nil {
+		log.Fatalf("failed to init token manager: %v", err)
+	}
+
+	s := grpc.NewServer(
+		grpc.UnaryInterceptor(middleware.UnaryAuthInterceptor(tm)),
+	)
+
+	// Status replies are chat messages; post them through the chat service's own rules
+	// (block checks, one-to-one chat reuse) against the shared database.
+	chatService := chatservice.NewChatService(chatservice.Repositories{
+		Chats:     chatstore.NewChatStore(db.DB),
+		Messages:  chatstore.NewMessageStore(db.DB, os.Getenv("CHAT_SEARCH_LANGUAGE")),
+		Invites:   chatstore.NewInviteStore(db.DB),
+		Blocks:    chatstore.NewBlockStore(db.DB),
+		Receipts:  chatstore.NewReceiptStore(db.DB),
+		Reactions: chatstore.NewReactionStore(db.DB),
+		Pins:      chatstore.NewPinStore(db.DB),
+		Polls:     chatstore.NewPollStore(db.DB, os.Getenv("CHAT_SEARCH_LANGUAGE")),
+		Calls:     chatstore.NewCallStore(db.DB),
+		Privacy:   chatstore.NewPrivacyStore(db.DB),
+	}, chatservice.DefaultConfig())
+
+	statusService := service.NewStatusService(service.Repositories{
+		Statuses: store.NewStatusStore(db.DB),
+		Views:    store.NewViewStore(db.DB),
+	}, chatService, service.DefaultConfig())
+	statusHandler := handler.NewStatusHandler(statusService)
+	statusHandler.Register(s)
+
+	log.Printf("status_service listening on %s (env=%s)", listenAddr, appEnv)
+	if err := s.Serve(lis); err != nil {
+		log.Fatalf("failed to serve: %v", err)
+	}
 }
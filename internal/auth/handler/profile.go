@@ -0,0 +1,238 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/dykethecreator/GoApp/internal/auth/middleware"
+	"github.com/dykethecreator/GoApp/internal/auth/service"
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/dykethecreator/GoApp/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UserHandler serves the authenticated UserService RPCs.
+type UserHandler struct {
+	proto.UnimplementedUserServiceServer
+	service *service.ProfileService
+}
+
+func NewUserHandler(service *service.ProfileService) *UserHandler {
+	return &UserHandler{service: service}
+}
+
+func (h *UserHandler) Register(s *grpc.Server) {
+	proto.RegisterUserServiceServer(s, h)
+}
+
+func (h *UserHandler) GetUserProfile(ctx context.Context, req *proto.GetUserProfileRequest) (*proto.GetUserProfileResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	user, err := h.service.GetProfile(ctx, userID, req.UserId)
+	if err != nil {
+		return nil, profileStatus("get user profile", err)
+	}
+	return &proto.GetUserProfileResponse{User: toProtoUser(user)}, nil
+}
+
+func (h *UserHandler) UpdateUserProfile(ctx context.Context, req *proto.UpdateUserProfileRequest) (*proto.UpdateUserProfileResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	user, err := h.service.UpdateProfile(ctx, userID, service.ProfileUpdate{
+		DisplayName:       req.DisplayName,
+		ProfilePictureURL: req.ProfilePictureUrl,
+		AboutText:         req.AboutText,
+	})
+	if err != nil {
+		return nil, profileStatus("update user profile", err)
+	}
+	return &proto.UpdateUserProfileResponse{User: toProtoUser(user)}, nil
+}
+
+func (h *UserHandler) BlockUser(ctx context.Context, req *proto.BlockUserRequest) (*proto.BlockUserResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.service.BlockUser(ctx, userID, req.UserId); err != nil {
+		return nil, profileStatus("block user", err)
+	}
+	return &proto.BlockUserResponse{Success: true}, nil
+}
+
+func (h *UserHandler) UnblockUser(ctx context.Context, req *proto.BlockUserRequest) (*proto.BlockUserResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.service.UnblockUser(ctx, userID, req.UserId); err != nil {
+		return nil, profileStatus("unblock user", err)
+	}
+	return &proto.BlockUserResponse{Success: true}, nil
+}
+
+func (h *UserHandler) ListBlockedUsers(ctx context.Context, req *proto.ListBlockedUsersRequest) (*proto.ListBlockedUsersResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	blocked, err := h.service.ListBlocked(ctx, userID)
+	if err != nil {
+		return nil, profileStatus("list blocked users", err)
+	}
+	resp := &proto.ListBlockedUsersResponse{}
+	for _, b := range blocked {
+		resp.Blocked = append(resp.Blocked, &proto.BlockedUser{
+			UserId:    b.BlockedUserID.String(),
+			BlockedAt: formatTime(b.CreatedAt),
+		})
+	}
+	return resp, nil
+}
+
+func (h *UserHandler) SyncContacts(ctx context.Context, req *proto.SyncContactsRequest) (*proto.SyncContactsResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	in := service.ContactSyncInput{
+		Removed:            req.RemovedPhoneNumbers,
+		ReplaceAll:         req.ReplaceAll,
+		DefaultCountryCode: req.DefaultCountryCode,
+	}
+	for _, c := range req.Contacts {
+		in.Contacts = append(in.Contacts, service.ContactEntry{PhoneNumber: c.PhoneNumber, DisplayName: c.DisplayName})
+	}
+	result, err := h.service.SyncContacts(ctx, userID, in)
+	if err != nil {
+		return nil, profileStatus("sync contacts", err)
+	}
+	resp := &proto.SyncContactsResponse{InvalidPhoneNumbers: result.Invalid}
+	for _, c := range result.Registered {
+		rc := &proto.RegisteredContact{PhoneNumber: c.ContactPhoneNumber, DisplayName: c.DisplayNameOverride}
+		if c.ContactUserID != nil {
+			rc.UserId = c.ContactUserID.String()
+		}
+		resp.Registered = append(resp.Registered, rc)
+	}
+	return resp, nil
+}
+
+func (h *UserHandler) GetPrivacySettings(ctx context.Context, req *proto.GetPrivacySettingsRequest) (*proto.PrivacySettingsResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	settings, err := h.service.GetPrivacySettings(ctx, userID)
+	if err != nil {
+		return nil, profileStatus("get privacy settings", err)
+	}
+	return &proto.PrivacySettingsResponse{Settings: toProtoPrivacySettings(settings)}, nil
+}
+
+func (h *UserHandler) UpdatePrivacySettings(ctx context.Context, req *proto.UpdatePrivacySettingsRequest) (*proto.PrivacySettingsResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	in := service.PrivacyUpdate{
+		Audiences:  map[domain.PrivacySetting]domain.PrivacyAudience{},
+		Exceptions: map[domain.PrivacySetting][]string{},
+	}
+	for setting, audience := range map[domain.PrivacySetting]string{
+		domain.LastSeenSetting:     req.LastSeen,
+		domain.OnlineSetting:       req.Online,
+		domain.ProfilePhotoSetting: req.ProfilePhoto,
+		domain.AboutSetting:        req.About,
+		domain.StatusSetting:       req.Status,
+		domain.GroupAddSetting:     req.GroupAdd,
+	} {
+		if audience != "" {
+			in.Audiences[setting] = domain.PrivacyAudience(audience)
+		}
+	}
+	for _, e := range req.Exceptions {
+		in.Exceptions[domain.PrivacySetting(e.Setting)] = e.UserIds
+	}
+	in.ReadReceipts = req.ReadReceipts
+	settings, err := h.service.UpdatePrivacySettings(ctx, userID, in)
+	if err != nil {
+		return nil, profileStatus("update privacy settings", err)
+	}
+	return &proto.PrivacySettingsResponse{Settings: toProtoPrivacySettings(settings)}, nil
+}
+
+// callerID returns the authenticated user injected by the auth interceptor.
+func callerID(ctx context.Context) (string, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || userID == "" {
+		return "", status.Error(codes.Unauthenticated, "missing authenticated user")
+	}
+	return userID, nil
+}
+
+// profileStatus maps ProfileService errors to gRPC status codes; unknown errors become Internal.
+func profileStatus(op string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidArgument):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrUserNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	}
+	log.Printf("%s failed: %v", op, err)
+	return status.Errorf(codes.Internal, "failed to %s", op)
+}
+
+func toProtoUser(u *domain.User) *proto.User {
+	return &proto.User{
+		Id:                u.ID.String(),
+		PhoneNumber:       u.PhoneNumber,
+		DisplayName:       u.DisplayName,
+		ProfilePictureUrl: u.ProfilePictureURL,
+		AboutText:         u.AboutText,
+		LastSeenAt:        formatTime(u.LastSeenAt),
+		CreatedAt:         formatTime(u.CreatedAt),
+		UpdatedAt:         formatTime(u.UpdatedAt),
+	}
+}
+
+func toProtoPrivacySettings(p *domain.PrivacySettings) *proto.PrivacySettings {
+	out := &proto.PrivacySettings{
+		LastSeen:     string(p.Audience(domain.LastSeenSetting)),
+		Online:       string(p.Audience(domain.OnlineSetting)),
+		ProfilePhoto: string(p.Audience(domain.ProfilePhotoSetting)),
+		About:        string(p.Audience(domain.AboutSetting)),
+		Status:       string(p.Audience(domain.StatusSetting)),
+		GroupAdd:     string(p.Audience(domain.GroupAddSetting)),
+		ReadReceipts: p.ReadReceipts,
+		UpdatedAt:    formatTime(p.UpdatedAt),
+	}
+	for _, setting := range domain.PrivacySettingNames {
+		ids := p.Exceptions[setting]
+		if len(ids) == 0 {
+			continue
+		}
+		e := &proto.PrivacyException{Setting: string(setting)}
+		for _, id := range ids {
+			e.UserIds = append(e.UserIds, id.String())
+		}
+		out.Exceptions = append(out.Exceptions, e)
+	}
+	return out
+}
+
+// formatTime renders timestamps as RFC3339 strings; zero times (e.g. hidden last seen) are empty.
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/dykethecreator/GoApp/pkg/domain"
+)
+
+// BlockRepository defines operations on blocked_users.
+type BlockRepository interface {
+	// Block records that blocker blocked blocked; blocking twice is a no-op.
+	Block(ctx context.Context, blockerID string, blockedID string) error
+	Unblock(ctx context.Context, blockerID string, blockedID string) error
+	// ListBlocked returns the users blockerID has blocked, most recent first.
+	ListBlocked(ctx context.Context, blockerID string) ([]*domain.BlockedUser, error)
+	// IsBlocked reports whether blockerID has blocked blockedID.
+	IsBlocked(ctx context.Context, blockerID string, blockedID string) (bool, error)
+}
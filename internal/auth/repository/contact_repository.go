@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/dykethecreator/GoApp/pkg/domain"
+)
+
+// ContactRepository defines operations on users' synced address books.
+type ContactRepository interface {
+	// UpsertContacts stores the contacts of userID, replacing display names of numbers
+	// already stored, and links each number to the registered user that owns it, if any.
+	UpsertContacts(ctx context.Context, userID string, contacts []*domain.Contact) error
+	// DeleteContacts removes the given numbers from userID's contacts.
+	DeleteContacts(ctx context.Context, userID string, phoneNumbers []string) error
+	// DeleteContactsExcept removes every contact of userID whose number is not in keep.
+	DeleteContactsExcept(ctx context.Context, userID string, keep []string) error
+	// ListRegistered returns userID's contacts that belong to registered users.
+	ListRegistered(ctx context.Context, userID string) ([]*domain.Contact, error)
+	// LinkRegisteredUser points every contact entry for phoneNumber at the user that just
+	// registered with it.
+	LinkRegisteredUser(ctx context.Context, phoneNumber string, userID string) error
+}
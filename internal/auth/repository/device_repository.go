@@ -2,14 +2,27 @@ package repository
 
 import (
 	"context"
+	"errors"
 
 	"github.com/dykethecreator/GoApp/pkg/domain"
 )
 
+// ErrDeviceNotActive is returned by RotateDevice when the session to rotate was already
+// revoked, or rotated by a concurrent refresh with the same token.
+var ErrDeviceNotActive = errors.New("device session is no longer active")
+
 // DeviceRepository defines operations for user device sessions (refresh tokens).
 type DeviceRepository interface {
 	UpsertDevice(ctx context.Context, dev *domain.UserDevice) error
 	FindActiveByUserAndHash(ctx context.Context, userID string, hash string) (*domain.UserDevice, error)
+	// RevokeByID and RevokeAllForUser end device sessions and record a DeviceRevoked event
+	// for each one.
 	RevokeByID(ctx context.Context, id string) error
 	RevokeAllForUser(ctx context.Context, userID string) error
+	// RotateDevice replaces the refresh token hash of session id, oldHash, with newHash
+	// when its refresh token is rotated. The session keeps its ID, which the device's
+	// tokens carry, and stays signed in, so no DeviceRevoked event is recorded. It fails
+	// with ErrDeviceNotActive, storing nothing, when the session is no longer active or
+	// no longer holds oldHash.
+	RotateDevice(ctx context.Context, id string, oldHash string, newHash string) error
 }
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/dykethecreator/GoApp/pkg/domain"
+)
+
+// PrivacyRepository defines operations on privacy_settings and privacy_exceptions.
+type PrivacyRepository interface {
+	// Find returns userID's privacy settings, or the defaults when they never changed them.
+	Find(ctx context.Context, userID string) (*domain.PrivacySettings, error)
+	// Save stores every audience of settings and replaces its exception lists.
+	Save(ctx context.Context, settings *domain.PrivacySettings) error
+	// IsContact reports whether ownerID has userID in their synced contacts.
+	IsContact(ctx context.Context, ownerID string, userID string) (bool, error)
+}
@@ -12,4 +12,7 @@ type UserRepository interface {
 	FindByPhoneNumber(ctx context.Context, phoneNumber string) (*domain.User, error)
 	CreateUser(ctx context.Context, user *domain.User) (*domain.User, error)
 	FindByID(ctx context.Context, userID string) (*domain.User, error)
+	// UpdateProfile sets the non-nil fields among display name, profile picture URL and
+	// about text and returns the updated user, or nil when the user does not exist.
+	UpdateProfile(ctx context.Context, userID string, displayName, pictureURL, aboutText *string) (*domain.User, error)
 }
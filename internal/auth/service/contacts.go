@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/dykethecreator/GoApp/pkg/domain"
+)
+
+const (
+	// maxContactsPerSync bounds the number of entries (added plus removed) in one SyncContacts call.
+	maxContactsPerSync = 5000
+	// maxContactNameRunes matches contacts.display_name_override VARCHAR(100).
+	maxContactNameRunes = 100
+)
+
+// ContactEntry is one address book entry as sent by the device.
+type ContactEntry struct {
+	PhoneNumber string
+	DisplayName string
+}
+
+// ContactSyncInput is a batch of address book changes. With ReplaceAll the batch is the
+// whole address book and stored contacts missing from it are removed; otherwise it is a
+// delta of added/changed entries plus removed numbers.
+type ContactSyncInput struct {
+	Contacts           []ContactEntry
+	Removed            []string
+	ReplaceAll         bool
+	DefaultCountryCode string // e.g. "90"; used for numbers written without an international prefix
+}
+
+// ContactSyncResult reports the caller's registered contacts after the sync and the
+// numbers that could not be normalized.
+type ContactSyncResult struct {
+	Registered []*domain.Contact
+	Invalid    []string
+}
+
+// SyncContacts stores the caller's address book changes with numbers normalized to E.164
+// and resolves which contacts are registered users.
+func (s *ProfileService) SyncContacts(ctx context.Context, callerID string, in ContactSyncInput) (*ContactSyncResult, error) {
+	if len(in.Contacts)+len(in.Removed) > maxContactsPerSync {
+		return nil, fmt.Errorf("%w: at most %d contacts per sync", ErrInvalidArgument, maxContactsPerSync)
+	}
+	cc := strings.TrimPrefix(strings.TrimSpace(in.DefaultCountryCode), "+")
+	if cc != "" && !isDigits(cc) {
+		return nil, fmt.Errorf("%w: invalid default country code", ErrInvalidArgument)
+	}
+
+	result := &ContactSyncResult{}
+	index := map[string]int{}
+	var contacts []*domain.Contact
+	for _, e := range in.Contacts {
+		number, ok := normalizePhoneNumber(e.PhoneNumber, cc)
+		if !ok {
+			result.Invalid = append(result.Invalid, e.PhoneNumber)
+			continue
+		}
+		c := &domain.Contact{ContactPhoneNumber: number, DisplayNameOverride: truncateRunes(strings.TrimSpace(e.DisplayName), maxContactNameRunes)}
+		// The same number may appear under several entries; the last one wins.
+		if i, seen := index[number]; seen {
+			contacts[i] = c
+			continue
+		}
+		index[number] = len(contacts)
+		contacts = append(contacts, c)
+	}
+	var removed []string
+	for _, raw := range in.Removed {
+		number, ok := normalizePhoneNumber(raw, cc)
+		if !ok {
+			result.Invalid = append(result.Invalid, raw)
+			continue
+		}
+		removed = append(removed, number)
+	}
+
+	if len(contacts) > 0 {
+		if err := s.contactRepo.UpsertContacts(ctx, callerID, contacts); err != nil {
+			return nil, err
+		}
+	}
+	if in.ReplaceAll {
+		keep := make([]string, 0, len(contacts))
+		for _, c := range contacts {
+			keep = append(keep, c.ContactPhoneNumber)
+		}
+		if err := s.contactRepo.DeleteContactsExcept(ctx, callerID, keep); err != nil {
+			return nil, err
+		}
+	} else if err := s.contactRepo.DeleteContacts(ctx, callerID, removed); err != nil {
+		return nil, err
+	}
+
+	registered, err := s.contactRepo.ListRegistered(ctx, callerID)
+	if err != nil {
+		return nil, err
+	}
+	result.Registered = registered
+	return result, nil
+}
+
+// normalizePhoneNumber converts an address book number to E.164 ("+" and 8 to 15 digits).
+// Numbers without an international prefix get defaultCC after their trunk prefix ("0") is
+// dropped; without defaultCC they cannot be normalized.
+func normalizePhoneNumber(raw string, defaultCC string) (string, bool) {
+	var b strings.Builder
+	plus := false
+	for i, r := range strings.TrimSpace(raw) {
+		switch {
+		case r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case r == '+' && i == 0:
+			plus = true
+		case r == ' ' || r == '-' || r == '(' || r == ')' || r == '.' || r == '/':
+		default:
+			return "", false
+		}
+	}
+	digits := b.String()
+	switch {
+	case plus:
+	case strings.HasPrefix(digits, "00"):
+		digits = digits[2:]
+	case defaultCC != "":
+		digits = defaultCC + strings.TrimLeft(digits, "0")
+	default:
+		return "", false
+	}
+	if len(digits) < 8 || len(digits) > 15 || digits[0] == '0' {
+		return "", false
+	}
+	return "+" + digits, true
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+func truncateRunes(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n])
+}
@@ -0,0 +1,35 @@
+package service
+
+import "testing"
+
+func TestNormalizePhoneNumber(t *testing.T) {
+	tests := []struct {
+		name      string
+		raw       string
+		defaultCC string
+		want      string
+		ok        bool
+	}{
+		{"international number keeps its country code", "+90 532 123 45 67", "1", "+905321234567", true},
+		{"00 prefix is an international prefix", "0044 20 7946 0958", "", "+442079460958", true},
+		{"separators are ignored", "(555) 123-4567", "1", "+15551234567", true},
+		{"dots and slashes are ignored", "030/1234.5678", "49", "+493012345678", true},
+		{"trunk prefix is dropped before the default country code", "0532 123 45 67", "90", "+905321234567", true},
+		{"surrounding spaces are trimmed", "  +4915112345678 ", "", "+4915112345678", true},
+		{"national number without a default country code", "5551234567", "", "", false},
+		{"letters are rejected", "+1 555 CALL NOW", "", "", false},
+		{"plus inside the number is rejected", "1+5551234567", "1", "", false},
+		{"too short", "+1234567", "", "", false},
+		{"too long", "+1234567890123456", "", "", false},
+		{"country code cannot start with 0", "+0123456789", "", "", false},
+		{"empty", "", "1", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := normalizePhoneNumber(tt.raw, tt.defaultCC)
+			if got != tt.want || ok != tt.ok {
+				t.Errorf("normalizePhoneNumber(%q, %q) = %q, %v, want %q, %v", tt.raw, tt.defaultCC, got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/google/uuid"
+)
+
+// maxPrivacyExceptions caps the exception list of a single setting.
+const maxPrivacyExceptions = 1000
+
+// PrivacyUpdate carries the privacy settings a client wants to change. Settings missing
+// from Audiences keep their value; an entry in Exceptions replaces that setting's list.
+type PrivacyUpdate struct {
+	Audiences    map[domain.PrivacySetting]domain.PrivacyAudience
+	Exceptions   map[domain.PrivacySetting][]string
+	ReadReceipts *bool // nil keeps the current value
+}
+
+// GetPrivacySettings returns the caller's privacy settings.
+func (s *ProfileService) GetPrivacySettings(ctx context.Context, callerID string) (*domain.PrivacySettings, error) {
+	return s.privacyRepo.Find(ctx, callerID)
+}
+
+// UpdatePrivacySettings applies the update to the caller's privacy settings and returns the result.
+func (s *ProfileService) UpdatePrivacySettings(ctx context.Context, callerID string, in PrivacyUpdate) (*domain.PrivacySettings, error) {
+	settings, err := s.privacyRepo.Find(ctx, callerID)
+	if err != nil {
+		return nil, err
+	}
+	for setting, audience := range in.Audiences {
+		if !knownPrivacySetting(setting) {
+			return nil, fmt.Errorf("%w: unknown privacy setting %q", ErrInvalidArgument, setting)
+		}
+		if !audience.Valid() {
+			return nil, fmt.Errorf("%w: invalid audience %q for %s", ErrInvalidArgument, audience, setting)
+		}
+		settings.Audiences[setting] = audience
+	}
+	for setting, userIDs := range in.Exceptions {
+		if !knownPrivacySetting(setting) {
+			return nil, fmt.Errorf("%w: unknown privacy setting %q", ErrInvalidArgument, setting)
+		}
+		if len(userIDs) > maxPrivacyExceptions {
+			return nil, fmt.Errorf("%w: at most %d exceptions per setting", ErrInvalidArgument, maxPrivacyExceptions)
+		}
+		ids := make([]uuid.UUID, 0, len(userIDs))
+		for _, raw := range userIDs {
+			id, err := uuid.Parse(raw)
+			if err != nil {
+				return nil, fmt.Errorf("%w: invalid user id %q in %s exceptions", ErrInvalidArgument, raw, setting)
+			}
+			if raw == callerID {
+				continue
+			}
+			ids = append(ids, id)
+		}
+		settings.Exceptions[setting] = ids
+	}
+	if in.ReadReceipts != nil {
+		settings.ReadReceipts = *in.ReadReceipts
+	}
+	if err := s.privacyRepo.Save(ctx, settings); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+// applyPrivacy clears the parts of user's profile that their privacy settings hide from viewerID.
+func (s *ProfileService) applyPrivacy(ctx context.Context, viewerID string, user *domain.User) error {
+	settings, err := s.privacyRepo.Find(ctx, user.ID.String())
+	if err != nil {
+		return err
+	}
+	viewer, err := uuid.Parse(viewerID)
+	if err != nil {
+		return fmt.Errorf("%w: invalid caller id", ErrInvalidArgument)
+	}
+	isContact := false
+	for _, setting := range []domain.PrivacySetting{domain.LastSeenSetting, domain.ProfilePhotoSetting, domain.AboutSetting} {
+		if settings.NeedsContact(setting) {
+			if isContact, err = s.privacyRepo.IsContact(ctx, user.ID.String(), viewerID); err != nil {
+				return err
+			}
+			break
+		}
+	}
+	if !settings.Allows(domain.LastSeenSetting, viewer, isContact) {
+		user.LastSeenAt = time.Time{}
+	}
+	if !settings.Allows(domain.ProfilePhotoSetting, viewer, isContact) {
+		user.ProfilePictureURL = ""
+	}
+	if !settings.Allows(domain.AboutSetting, viewer, isContact) {
+		user.AboutText = ""
+	}
+	return nil
+}
+
+func knownPrivacySetting(setting domain.PrivacySetting) bool {
+	for _, s := range domain.PrivacySettingNames {
+		if s == setting {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dykethecreator/GoApp/internal/auth/repository"
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/google/uuid"
+)
+
+// Errors returned by ProfileService. The handler maps them to gRPC status codes.
+var (
+	ErrInvalidArgument = errors.New("invalid argument")
+	ErrUserNotFound    = errors.New("user not found")
+)
+
+// ProfileService serves user profiles to other users and manages blocking, contacts and
+// privacy settings.
+type ProfileService struct {
+	userRepo    repository.UserRepository
+	blockRepo   repository.BlockRepository
+	contactRepo repository.ContactRepository
+	privacyRepo repository.PrivacyRepository
+}
+
+func NewProfileService(userRepo repository.UserRepository, blockRepo repository.BlockRepository, contactRepo repository.ContactRepository, privacyRepo repository.PrivacyRepository) *ProfileService {
+	return &ProfileService{userRepo: userRepo, blockRepo: blockRepo, contactRepo: contactRepo, privacyRepo: privacyRepo}
+}
+
+// GetProfile returns the user's profile as the viewer may see it. Last seen, profile
+// picture and about text follow the user's privacy settings; users who have blocked the
+// viewer also show neither their last seen time nor their profile picture.
+func (s *ProfileService) GetProfile(ctx context.Context, viewerID string, userID string) (*domain.User, error) {
+	if _, err := uuid.Parse(userID); err != nil {
+		return nil, fmt.Errorf("%w: invalid user id", ErrInvalidArgument)
+	}
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+	if viewerID == userID {
+		return user, nil
+	}
+	if err := s.applyPrivacy(ctx, viewerID, user); err != nil {
+		return nil, err
+	}
+	// A block only ever hides more than the privacy settings do.
+	blocked, err := s.blockRepo.IsBlocked(ctx, userID, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	if blocked {
+		user.LastSeenAt = time.Time{}
+		user.ProfilePictureURL = ""
+	}
+	return user, nil
+}
+
+// ProfileUpdate holds the profile fields to change; nil fields are left untouched.
+type ProfileUpdate struct {
+	DisplayName       *string
+	ProfilePictureURL *string
+	AboutText         *string
+}
+
+// UpdateProfile changes the caller's own profile and returns it as it is now.
+func (s *ProfileService) UpdateProfile(ctx context.Context, callerID string, upd ProfileUpdate) (*domain.User, error) {
+	if upd.DisplayName != nil {
+		name := strings.TrimSpace(*upd.DisplayName)
+		if name == "" {
+			return nil, fmt.Errorf("%w: display name cannot be empty", ErrInvalidArgument)
+		}
+		upd.DisplayName = &name
+	}
+	if upd.DisplayName == nil && upd.ProfilePictureURL == nil && upd.AboutText == nil {
+		return s.GetProfile(ctx, callerID, callerID)
+	}
+	user, err := s.userRepo.UpdateProfile(ctx, callerID, upd.DisplayName, upd.ProfilePictureURL, upd.AboutText)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+	return user, nil
+}
+
+// BlockUser blocks another user for the caller.
+func (s *ProfileService) BlockUser(ctx context.Context, callerID string, userID string) error {
+	if err := s.requireOtherUser(ctx, callerID, userID); err != nil {
+		return err
+	}
+	return s.blockRepo.Block(ctx, callerID, userID)
+}
+
+// UnblockUser lifts a block; unblocking a user who is not blocked is a no-op.
+func (s *ProfileService) UnblockUser(ctx context.Context, callerID string, userID string) error {
+	if _, err := uuid.Parse(userID); err != nil {
+		return fmt.Errorf("%w: invalid user id", ErrInvalidArgument)
+	}
+	return s.blockRepo.Unblock(ctx, callerID, userID)
+}
+
+// ListBlocked returns the users the caller has blocked, most recent first.
+func (s *ProfileService) ListBlocked(ctx context.Context, callerID string) ([]*domain.BlockedUser, error) {
+	return s.blockRepo.ListBlocked(ctx, callerID)
+}
+
+func (s *ProfileService) requireOtherUser(ctx context.Context, callerID string, userID string) error {
+	if _, err := uuid.Parse(userID); err != nil {
+		return fmt.Errorf("%w: invalid user id", ErrInvalidArgument)
+	}
+	if userID == callerID {
+		return fmt.Errorf("%w: you cannot block yourself", ErrInvalidArgument)
+	}
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return ErrUserNotFound
+	}
+	return nil
+}
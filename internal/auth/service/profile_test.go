@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dykethecreator/GoApp/internal/auth/repository"
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/google/uuid"
+)
+
+// blockedBy is a BlockRepository in which blocker has blocked everyone.
+type blockedBy struct {
+	repository.BlockRepository
+	blocker string
+}
+
+func (r blockedBy) IsBlocked(_ context.Context, blockerID string, _ string) (bool, error) {
+	return blockerID == r.blocker, nil
+}
+
+// fixedPrivacy is a PrivacyRepository over one user's settings, with no contacts.
+type fixedPrivacy struct {
+	repository.PrivacyRepository
+	settings *domain.PrivacySettings
+}
+
+func (r fixedPrivacy) Find(_ context.Context, _ string) (*domain.PrivacySettings, error) {
+	return r.settings, nil
+}
+
+func (r fixedPrivacy) IsContact(_ context.Context, _ string, _ string) (bool, error) {
+	return false, nil
+}
+
+func TestGetProfile(t *testing.T) {
+	owner, viewer := uuid.New(), uuid.New()
+	profile := func() *domain.User {
+		return &domain.User{ID: owner, AboutText: "hiking", ProfilePictureURL: "https://cdn/me.jpg", LastSeenAt: time.Now()}
+	}
+	tests := []struct {
+		name      string
+		about     domain.PrivacyAudience
+		blocked   bool
+		wantAbout bool
+		wantPhoto bool
+	}{
+		{name: "everyone", about: domain.AudienceEveryone, wantAbout: true, wantPhoto: true},
+		{name: "about hidden by privacy", about: domain.AudienceNobody, wantPhoto: true},
+		{name: "blocked viewer keeps the about privacy", about: domain.AudienceNobody, blocked: true},
+		{name: "blocked viewer sees about shared with everyone", about: domain.AudienceEveryone, blocked: true, wantAbout: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings := domain.DefaultPrivacySettings(owner)
+			settings.Audiences[domain.AboutSetting] = tt.about
+			blocks := blockedBy{}
+			if tt.blocked {
+				blocks.blocker = owner.String()
+			}
+			s := NewProfileService(knownUser{user: profile()}, blocks, nil, fixedPrivacy{settings: settings})
+			got, err := s.GetProfile(context.Background(), viewer.String(), owner.String())
+			if err != nil {
+				t.Fatalf("GetProfile: %v", err)
+			}
+			if (got.AboutText != "") != tt.wantAbout {
+				t.Errorf("about = %q, want shown %v", got.AboutText, tt.wantAbout)
+			}
+			if (got.ProfilePictureURL != "") != tt.wantPhoto {
+				t.Errorf("profile picture = %q, want shown %v", got.ProfilePictureURL, tt.wantPhoto)
+			}
+			if tt.blocked && !got.LastSeenAt.IsZero() {
+				t.Error("blocked viewer sees last seen")
+			}
+		})
+	}
+}
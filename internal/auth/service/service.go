@@ -13,6 +13,7 @@ import (
 	"github.com/dykethecreator/GoApp/internal/auth/repository"
 	"github.com/dykethecreator/GoApp/pkg/domain"
 	"github.com/dykethecreator/GoApp/pkg/jwt"
+	"github.com/google/uuid"
 	"github.com/twilio/twilio-go"
 	verify "github.com/twilio/twilio-go/rest/verify/v2"
 )
@@ -22,10 +23,11 @@ type AuthService struct {
 	verifyServiceSID string
 	userRepo         repository.UserRepository
 	deviceRepo       repository.DeviceRepository
+	contactRepo      repository.ContactRepository
 	tokenManager     *jwt.TokenManager
 }
 
-func NewAuthService(userRepo repository.UserRepository, deviceRepo repository.DeviceRepository) *AuthService {
+func NewAuthService(userRepo repository.UserRepository, deviceRepo repository.DeviceRepository, contactRepo repository.ContactRepository) *AuthService {
 	accountSid := os.Getenv("TWILIO_ACCOUNT_SID")
 	authToken := os.Getenv("TWILIO_AUTH_TOKEN")
 	verifyServiceSID := os.Getenv("TWILIO_VERIFY_SERVICE_SID")
@@ -54,6 +56,7 @@ func NewAuthService(userRepo repository.UserRepository, deviceRepo repository.De
 		verifyServiceSID: verifyServiceSID,
 		userRepo:         userRepo,
 		deviceRepo:       deviceRepo,
+		contactRepo:      contactRepo,
 		tokenManager:     tokenManager,
 	}
 }
@@ -111,12 +114,19 @@ func (s *AuthService) VerifyOTP(ctx context.Context, phoneNumber, code string) (
 			return "", "", err
 		}
 		log.Printf("New user created with ID: %s", user.ID)
+		// Users who synced this number before it registered now see it as a registered contact.
+		if s.contactRepo != nil {
+			if err := s.contactRepo.LinkRegisteredUser(ctx, user.PhoneNumber, user.ID.String()); err != nil {
+				log.Printf("Warning: failed to link contacts to new user %s: %v", user.ID, err)
+			}
+		}
 	} else {
 		log.Printf("Found existing user with ID: %s", user.ID)
 	}
 
-	// 4. Generate tokens for the user
-	accessToken, refreshToken, err := s.tokenManager.GenerateTokens(user.ID.String())
+	// 4. Generate tokens for the user, bound to a new device session
+	deviceID := uuid.New()
+	accessToken, refreshToken, err := s.tokenManager.GenerateTokens(user.ID.String(), deviceID.String())
 	if err != nil {
 		log.Printf("Error generating tokens for user %s: %v", user.ID, err)
 		return "", "", err
@@ -141,6 +151,7 @@ func (s *AuthService) VerifyOTP(ctx context.Context, phoneNumber, code string) (
 	if s.deviceRepo != nil {
 		hash := hashRefreshToken(refreshToken)
 		dev := &domain.UserDevice{
+			ID:               deviceID,
 			UserID:           user.ID,
 			RefreshTokenHash: hash,
 			DeviceName:       "unknown",
@@ -193,36 +204,27 @@ func (s *AuthService) RefreshToken(ctx context.Context, refreshTokenString strin
 		return "", "", errors.New("user not found for the given token")
 	}
 
-	// 5. Issue new access and refresh tokens (rotation)
-	newAccessToken, newRefreshToken, err := s.tokenManager.GenerateTokens(userID)
+	// 5. Issue new access and refresh tokens (rotation) for the same device
+	deviceID := claims.DeviceID
+	if currentDev != nil {
+		deviceID = currentDev.ID.String()
+	}
+	newAccessToken, newRefreshToken, err := s.tokenManager.GenerateTokens(userID, deviceID)
 	if err != nil {
 		log.Printf("Error generating new tokens for user %s: %v", userID, err)
 		return "", "", err
 	}
 
-	// 6. Persist the new refresh token hash and revoke the old one
-	if s.deviceRepo != nil {
-		// Revoke old device session to prevent reuse (rotate)
-		if currentDev != nil {
-			if rerr := s.deviceRepo.RevokeByID(ctx, currentDev.ID.String()); rerr != nil {
-				log.Printf("Warning: failed to revoke old device %s for user %s: %v", currentDev.ID, userID, rerr)
+	// 6. Persist the new refresh token hash in place of the old one. The tokens are only
+	// handed out once the rotation is stored, so a refresh token works exactly once. The
+	// session keeps its ID, so the device's tokens and sync position stay bound to it.
+	if currentDev != nil {
+		if rerr := s.deviceRepo.RotateDevice(ctx, currentDev.ID.String(), currentDev.RefreshTokenHash, hashRefreshToken(newRefreshToken)); rerr != nil {
+			if errors.Is(rerr, repository.ErrDeviceNotActive) {
+				return "", "", jwt.ErrInvalidToken
 			}
-		}
-		// Create a new device session record, copy metadata if available
-		newDev := &domain.UserDevice{
-			UserID:           user.ID,
-			RefreshTokenHash: hashRefreshToken(newRefreshToken),
-			DeviceName:       "unknown",
-			DeviceType:       "unknown",
-			LastLoginAt:      time.Now(),
-		}
-		if currentDev != nil {
-			newDev.DeviceName = currentDev.DeviceName
-			newDev.DeviceType = currentDev.DeviceType
-			newDev.PushNotificationToken = currentDev.PushNotificationToken
-		}
-		if uerr := s.deviceRepo.UpsertDevice(ctx, newDev); uerr != nil {
-			log.Printf("Warning: failed to upsert new device for user %s: %v", userID, uerr)
+			log.Printf("Error rotating device %s for user %s: %v", currentDev.ID, userID, rerr)
+			return "", "", rerr
 		}
 	}
 
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dykethecreator/GoApp/internal/auth/repository"
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/dykethecreator/GoApp/pkg/jwt"
+	"github.com/google/uuid"
+)
+
+// racingDevices is a DeviceRepository in which every lookup still sees the session, as
+// when concurrent refreshes read it before either rotated it; only one rotation wins.
+type racingDevices struct {
+	repository.DeviceRepository
+	dev     *domain.UserDevice
+	rotated int
+}
+
+func (r *racingDevices) FindActiveByUserAndHash(_ context.Context, _ string, _ string) (*domain.UserDevice, error) {
+	return r.dev, nil
+}
+
+func (r *racingDevices) RotateDevice(_ context.Context, _ string, _ string, _ string) error {
+	if r.rotated > 0 {
+		return repository.ErrDeviceNotActive
+	}
+	r.rotated++
+	return nil
+}
+
+type knownUser struct {
+	repository.UserRepository
+	user *domain.User
+}
+
+func (r knownUser) FindByID(_ context.Context, _ string) (*domain.User, error) {
+	return r.user, nil
+}
+
+func TestRefreshTokenRotatesOnce(t *testing.T) {
+	tm, err := jwt.NewTokenManager("test-secret-test-secret-test-secret", time.Minute, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	user, deviceID := &domain.User{ID: uuid.New()}, uuid.New()
+	_, refresh, err := tm.GenerateTokens(user.ID.String(), deviceID.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	devices := &racingDevices{dev: &domain.UserDevice{ID: deviceID, UserID: user.ID}}
+	s := &AuthService{userRepo: knownUser{user: user}, deviceRepo: devices, tokenManager: tm}
+
+	access, next, err := s.RefreshToken(context.Background(), refresh)
+	if err != nil || access == "" || next == "" {
+		t.Fatalf("first RefreshToken = %q, %q, %v", access, next, err)
+	}
+	// The rotated tokens stay bound to the same device session.
+	if claims, err := tm.ValidateToken(access); err != nil || claims.DeviceID != deviceID.String() {
+		t.Errorf("rotated access token device = %v, %v; want %s", claims, err, deviceID)
+	}
+	access, next, err = s.RefreshToken(context.Background(), refresh)
+	if !errors.Is(err, jwt.ErrInvalidToken) {
+		t.Fatalf("second RefreshToken err = %v, want ErrInvalidToken", err)
+	}
+	if access != "" || next != "" {
+		t.Errorf("second RefreshToken returned tokens %q, %q", access, next)
+	}
+}
+
+// revokedDevices is a DeviceRepository in which the session was signed out.
+type revokedDevices struct {
+	repository.DeviceRepository
+	rotated bool
+}
+
+func (r *revokedDevices) FindActiveByUserAndHash(_ context.Context, _ string, _ string) (*domain.UserDevice, error) {
+	return nil, nil
+}
+
+func (r *revokedDevices) RotateDevice(_ context.Context, _ string, _ string, _ string) error {
+	r.rotated = true
+	return nil
+}
+
+func TestRefreshTokenAfterRevoke(t *testing.T) {
+	tm, err := jwt.NewTokenManager("test-secret-test-secret-test-secret", time.Minute, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	user, deviceID := &domain.User{ID: uuid.New()}, uuid.New()
+	_, refresh, err := tm.GenerateTokens(user.ID.String(), deviceID.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	devices := &revokedDevices{}
+	s := &AuthService{userRepo: knownUser{user: user}, deviceRepo: devices, tokenManager: tm}
+
+	access, next, err := s.RefreshToken(context.Background(), refresh)
+	if !errors.Is(err, jwt.ErrInvalidToken) {
+		t.Fatalf("RefreshToken err = %v, want ErrInvalidToken", err)
+	}
+	if access != "" || next != "" {
+		t.Errorf("RefreshToken returned tokens %q, %q", access, next)
+	}
+	if devices.rotated {
+		t.Error("a revoked session was rotated")
+	}
+}
@@ -0,0 +1,55 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/dykethecreator/GoApp/internal/auth/repository"
+	"github.com/dykethecreator/GoApp/pkg/domain"
+)
+
+// BlockStore implements BlockRepository for PostgreSQL.
+type BlockStore struct {
+	db *sql.DB
+}
+
+func NewBlockStore(db *sql.DB) repository.BlockRepository {
+	return &BlockStore{db: db}
+}
+
+func (s *BlockStore) Block(ctx context.Context, blockerID string, blockedID string) error {
+	q := `INSERT INTO blocked_users (blocker_user_id, blocked_user_id) VALUES ($1, $2) ON CONFLICT DO NOTHING`
+	_, err := s.db.ExecContext(ctx, q, blockerID, blockedID)
+	return err
+}
+
+func (s *BlockStore) Unblock(ctx context.Context, blockerID string, blockedID string) error {
+	_, err := s.db.ExecContext(ctx, `DELETE FROM blocked_users WHERE blocker_user_id = $1 AND blocked_user_id = $2`, blockerID, blockedID)
+	return err
+}
+
+func (s *BlockStore) ListBlocked(ctx context.Context, blockerID string) ([]*domain.BlockedUser, error) {
+	q := `SELECT blocker_user_id, blocked_user_id, created_at FROM blocked_users WHERE blocker_user_id = $1 ORDER BY created_at DESC`
+	rows, err := s.db.QueryContext(ctx, q, blockerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []*domain.BlockedUser
+	for rows.Next() {
+		var b domain.BlockedUser
+		if err := rows.Scan(&b.BlockerUserID, &b.BlockedUserID, &b.CreatedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, &b)
+	}
+	return out, rows.Err()
+}
+
+func (s *BlockStore) IsBlocked(ctx context.Context, blockerID string, blockedID string) (bool, error) {
+	q := `SELECT EXISTS (SELECT 1 FROM blocked_users WHERE blocker_user_id = $1 AND blocked_user_id = $2)`
+	var blocked bool
+	err := s.db.QueryRowContext(ctx, q, blockerID, blockedID).Scan(&blocked)
+	return blocked, err
+}
@@ -0,0 +1,87 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/dykethecreator/GoApp/internal/auth/repository"
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/lib/pq"
+)
+
+// ContactStore implements ContactRepository for PostgreSQL.
+type ContactStore struct {
+	db *sql.DB
+}
+
+func NewContactStore(db *sql.DB) repository.ContactRepository {
+	return &ContactStore{db: db}
+}
+
+func (s *ContactStore) UpsertContacts(ctx context.Context, userID string, contacts []*domain.Contact) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	q := `
+	INSERT INTO contacts (user_id, contact_phone_number, contact_user_id, display_name_override)
+	VALUES ($1, $2, (SELECT id FROM users WHERE phone_number = $2), NULLIF($3, ''))
+	ON CONFLICT (user_id, contact_phone_number)
+	DO UPDATE SET contact_user_id = EXCLUDED.contact_user_id, display_name_override = EXCLUDED.display_name_override
+	`
+	stmt, err := tx.PrepareContext(ctx, q)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	for _, c := range contacts {
+		if _, err := stmt.ExecContext(ctx, userID, c.ContactPhoneNumber, c.DisplayNameOverride); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (s *ContactStore) DeleteContacts(ctx context.Context, userID string, phoneNumbers []string) error {
+	if len(phoneNumbers) == 0 {
+		return nil
+	}
+	q := `DELETE FROM contacts WHERE user_id = $1 AND contact_phone_number = ANY($2)`
+	_, err := s.db.ExecContext(ctx, q, userID, pq.Array(phoneNumbers))
+	return err
+}
+
+func (s *ContactStore) DeleteContactsExcept(ctx context.Context, userID string, keep []string) error {
+	q := `DELETE FROM contacts WHERE user_id = $1 AND NOT (contact_phone_number = ANY($2))`
+	_, err := s.db.ExecContext(ctx, q, userID, pq.Array(keep))
+	return err
+}
+
+func (s *ContactStore) ListRegistered(ctx context.Context, userID string) ([]*domain.Contact, error) {
+	q := `SELECT user_id, contact_phone_number, contact_user_id, COALESCE(display_name_override, '')
+	FROM contacts WHERE user_id = $1 AND contact_user_id IS NOT NULL
+	ORDER BY contact_phone_number`
+	rows, err := s.db.QueryContext(ctx, q, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []*domain.Contact
+	for rows.Next() {
+		var c domain.Contact
+		if err := rows.Scan(&c.UserID, &c.ContactPhoneNumber, &c.ContactUserID, &c.DisplayNameOverride); err != nil {
+			return nil, err
+		}
+		out = append(out, &c)
+	}
+	return out, rows.Err()
+}
+
+func (s *ContactStore) LinkRegisteredUser(ctx context.Context, phoneNumber string, userID string) error {
+	q := `UPDATE contacts SET contact_user_id = $2 WHERE contact_phone_number = $1 AND contact_user_id IS DISTINCT FROM $2`
+	_, err := s.db.ExecContext(ctx, q, phoneNumber, userID)
+	return err
+}
@@ -0,0 +1,42 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/dykethecreator/GoApp/internal/outbox"
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/dykethecreator/GoApp/pkg/eventbus"
+	pb "github.com/dykethecreator/GoApp/proto"
+)
+
+// outboxProducer names the auth service as the producer of the events it records.
+const outboxProducer = "auth_service"
+
+// enqueueProfileChanged records a UserProfileChanged event carrying user's profile, inside tx.
+func enqueueProfileChanged(ctx context.Context, tx *sql.Tx, user *domain.User) error {
+	ev := &pb.UserProfileChanged{
+		UserId:            user.ID.String(),
+		DisplayName:       user.DisplayName,
+		ProfilePictureUrl: user.ProfilePictureURL,
+		AboutText:         user.AboutText,
+		UpdatedAt:         formatEventTime(user.UpdatedAt),
+	}
+	return outbox.EnqueueEvent(ctx, tx, eventbus.TopicUserEvents, ev.UserId, outboxProducer, ev, user.UpdatedAt)
+}
+
+// enqueueDeviceRevoked records a DeviceRevoked event for one device session, inside tx.
+func enqueueDeviceRevoked(ctx context.Context, tx *sql.Tx, userID string, deviceID string, at time.Time) error {
+	ev := &pb.DeviceRevoked{
+		UserId:    userID,
+		DeviceId:  deviceID,
+		RevokedAt: formatEventTime(at),
+	}
+	return outbox.EnqueueEvent(ctx, tx, eventbus.TopicUserEvents, userID, outboxProducer, ev, at)
+}
+
+// formatEventTime renders event timestamps as RFC3339 strings.
+func formatEventTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339Nano)
+}
@@ -111,3 +111,47 @@ func (s *UserStore) FindByID(ctx context.Context, userID string) (*domain.User,
 
 	return user, nil
 }
+
+// UpdateProfile changes the user's profile and records a UserProfileChanged event in the
+// same transaction.
+func (s *UserStore) UpdateProfile(ctx context.Context, userID string, displayName, pictureURL, aboutText *string) (*domain.User, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	query := `UPDATE users SET
+		display_name = COALESCE($2, display_name),
+		profile_picture_url = COALESCE($3, profile_picture_url),
+		about_text = COALESCE($4, about_text),
+		updated_at = NOW()
+	WHERE id = $1
+	RETURNING id, phone_number, display_name, profile_picture_url, about_text, last_seen_at, created_at, updated_at`
+
+	user := &domain.User{}
+	err = tx.QueryRowContext(ctx, query, userID, displayName, pictureURL, aboutText).Scan(
+		&user.ID,
+		&user.PhoneNumber,
+		&user.DisplayName,
+		&user.ProfilePictureURL,
+		&user.AboutText,
+		&user.LastSeenAt,
+		&user.CreatedAt,
+		&user.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil // User not found
+		}
+		return nil, err
+	}
+
+	if err := enqueueProfileChanged(ctx, tx, user); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
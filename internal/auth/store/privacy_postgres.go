@@ -0,0 +1,119 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/dykethecreator/GoApp/internal/auth/repository"
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/google/uuid"
+)
+
+// PrivacyStore implements PrivacyRepository for PostgreSQL.
+type PrivacyStore struct {
+	db *sql.DB
+}
+
+func NewPrivacyStore(db *sql.DB) repository.PrivacyRepository {
+	return &PrivacyStore{db: db}
+}
+
+func (s *PrivacyStore) Find(ctx context.Context, userID string) (*domain.PrivacySettings, error) {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, err
+	}
+	settings := domain.DefaultPrivacySettings(id)
+
+	q := `SELECT last_seen, online, profile_photo, about, status, group_add, read_receipts, updated_at FROM privacy_settings WHERE user_id = $1`
+	var lastSeen, online, photo, about, status, groupAdd string
+	err = s.db.QueryRowContext(ctx, q, userID).Scan(&lastSeen, &online, &photo, &about, &status, &groupAdd, &settings.ReadReceipts, &settings.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return settings, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	settings.Audiences[domain.LastSeenSetting] = domain.PrivacyAudience(lastSeen)
+	settings.Audiences[domain.OnlineSetting] = domain.PrivacyAudience(online)
+	settings.Audiences[domain.ProfilePhotoSetting] = domain.PrivacyAudience(photo)
+	settings.Audiences[domain.AboutSetting] = domain.PrivacyAudience(about)
+	settings.Audiences[domain.StatusSetting] = domain.PrivacyAudience(status)
+	settings.Audiences[domain.GroupAddSetting] = domain.PrivacyAudience(groupAdd)
+
+	rows, err := s.db.QueryContext(ctx, `SELECT setting, excluded_user_id FROM privacy_exceptions WHERE user_id = $1`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var setting string
+		var excluded uuid.UUID
+		if err := rows.Scan(&setting, &excluded); err != nil {
+			return nil, err
+		}
+		key := domain.PrivacySetting(setting)
+		settings.Exceptions[key] = append(settings.Exceptions[key], excluded)
+	}
+	return settings, rows.Err()
+}
+
+func (s *PrivacyStore) Save(ctx context.Context, settings *domain.PrivacySettings) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	q := `
+	INSERT INTO privacy_settings (user_id, last_seen, online, profile_photo, about, status, group_add, read_receipts, updated_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW())
+	ON CONFLICT (user_id) DO UPDATE SET
+		last_seen = EXCLUDED.last_seen,
+		online = EXCLUDED.online,
+		profile_photo = EXCLUDED.profile_photo,
+		about = EXCLUDED.about,
+		status = EXCLUDED.status,
+		group_add = EXCLUDED.group_add,
+		read_receipts = EXCLUDED.read_receipts,
+		updated_at = EXCLUDED.updated_at
+	RETURNING updated_at
+	`
+	err = tx.QueryRowContext(ctx, q, settings.UserID,
+		string(settings.Audience(domain.LastSeenSetting)),
+		string(settings.Audience(domain.OnlineSetting)),
+		string(settings.Audience(domain.ProfilePhotoSetting)),
+		string(settings.Audience(domain.AboutSetting)),
+		string(settings.Audience(domain.StatusSetting)),
+		string(settings.Audience(domain.GroupAddSetting)),
+		settings.ReadReceipts,
+	).Scan(&settings.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM privacy_exceptions WHERE user_id = $1`, settings.UserID); err != nil {
+		return err
+	}
+	stmt, err := tx.PrepareContext(ctx, `INSERT INTO privacy_exceptions (user_id, setting, excluded_user_id) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING`)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	for setting, ids := range settings.Exceptions {
+		for _, id := range ids {
+			if _, err := stmt.ExecContext(ctx, settings.UserID, string(setting), id); err != nil {
+				return err
+			}
+		}
+	}
+	return tx.Commit()
+}
+
+func (s *PrivacyStore) IsContact(ctx context.Context, ownerID string, userID string) (bool, error) {
+	q := `SELECT EXISTS (SELECT 1 FROM contacts WHERE user_id = $1 AND contact_user_id = $2)`
+	var ok bool
+	err := s.db.QueryRowContext(ctx, q, ownerID, userID).Scan(&ok)
+	return ok, err
+}
@@ -21,6 +21,15 @@ func NewUserDeviceStore(db *sql.DB) repository.DeviceRepository {
 }
 
 func (s *UserDeviceStore) UpsertDevice(ctx context.Context, dev *domain.UserDevice) error {
+	return upsertDevice(ctx, s.db, dev)
+}
+
+// execer is satisfied by both *sql.DB and *sql.Tx.
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+func upsertDevice(ctx context.Context, db execer, dev *domain.UserDevice) error {
 	if dev.ID == uuid.Nil {
 		dev.ID = uuid.New()
 	}
@@ -33,7 +42,7 @@ func (s *UserDeviceStore) UpsertDevice(ctx context.Context, dev *domain.UserDevi
 	ON CONFLICT (user_id, refresh_token_hash)
 	DO UPDATE SET last_login_at = EXCLUDED.last_login_at
 	`
-	_, err := s.db.ExecContext(ctx, q,
+	_, err := db.ExecContext(ctx, q,
 		dev.ID,
 		dev.UserID,
 		dev.RefreshTokenHash,
@@ -61,13 +70,70 @@ func (s *UserDeviceStore) FindActiveByUserAndHash(ctx context.Context, userID st
 }
 
 func (s *UserDeviceStore) RevokeByID(ctx context.Context, id string) error {
-	q := `UPDATE user_devices SET revoked_at = NOW() WHERE id = $1 AND revoked_at IS NULL`
-	_, err := s.db.ExecContext(ctx, q, id)
-	return err
+	q := `UPDATE user_devices SET revoked_at = NOW() WHERE id = $1 AND revoked_at IS NULL
+	RETURNING id, user_id, revoked_at`
+	return s.revoke(ctx, q, id)
 }
 
 func (s *UserDeviceStore) RevokeAllForUser(ctx context.Context, userID string) error {
-	q := `UPDATE user_devices SET revoked_at = NOW() WHERE user_id = $1 AND revoked_at IS NULL`
-	_, err := s.db.ExecContext(ctx, q, userID)
-	return err
+	q := `UPDATE user_devices SET revoked_at = NOW() WHERE user_id = $1 AND revoked_at IS NULL
+	RETURNING id, user_id, revoked_at`
+	return s.revoke(ctx, q, userID)
+}
+
+// revoke runs a revoking UPDATE returning (id, user_id, revoked_at) and records a
+// DeviceRevoked event for every session it ended, in one transaction.
+func (s *UserDeviceStore) revoke(ctx context.Context, q string, arg string) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, q, arg)
+	if err != nil {
+		return err
+	}
+	type revoked struct {
+		deviceID, userID string
+		at               time.Time
+	}
+	var ended []revoked
+	for rows.Next() {
+		var r revoked
+		if err := rows.Scan(&r.deviceID, &r.userID, &r.at); err != nil {
+			rows.Close()
+			return err
+		}
+		ended = append(ended, r)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, r := range ended {
+		if err := enqueueDeviceRevoked(ctx, tx, r.userID, r.deviceID, r.at); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (s *UserDeviceStore) RotateDevice(ctx context.Context, id string, oldHash string, newHash string) error {
+	q := `UPDATE user_devices SET refresh_token_hash = $3, last_login_at = NOW()
+	WHERE id = $1 AND refresh_token_hash = $2 AND revoked_at IS NULL`
+	res, err := s.db.ExecContext(ctx, q, id, oldHash, newHash)
+	if err != nil {
+		return err
+	}
+	// Only one of several refreshes racing with the same token may win the rotation.
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n != 1 {
+		return repository.ErrDeviceNotActive
+	}
+	return nil
 }
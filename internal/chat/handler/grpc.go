@@ -0,0 +1,801 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/dykethecreator/GoApp/internal/auth/middleware"
+	"github.com/dykethecreator/GoApp/internal/chat/repository"
+	"github.com/dykethecreator/GoApp/internal/chat/service"
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/dykethecreator/GoApp/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ChatHandler struct {
+	proto.UnimplementedChatServiceServer
+	service *service.ChatService
+}
+
+func NewChatHandler(service *service.ChatService) *ChatHandler {
+	return &ChatHandler{service: service}
+}
+
+func (h *ChatHandler) Register(s *grpc.Server) {
+	proto.RegisterChatServiceServer(s, h)
+}
+
+func (h *ChatHandler) GetChats(ctx context.Context, req *proto.GetChatsRequest) (*proto.GetChatsResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	summaries, err := h.service.GetChats(ctx, userID, repository.ChatListFilter(req.Filter))
+	if err != nil {
+		return nil, toStatus("get chats", err)
+	}
+	resp := &proto.GetChatsResponse{}
+	for _, cs := range summaries {
+		resp.Chats = append(resp.Chats, toProtoChatSummary(cs))
+	}
+	return resp, nil
+}
+
+func (h *ChatHandler) CreateChat(ctx context.Context, req *proto.CreateChatRequest) (*proto.CreateChatResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	chat, restricted, err := h.service.CreateChat(ctx, userID, req.Name, req.MemberIds, req.IsGroup)
+	if err != nil {
+		return nil, toStatus("create chat", err)
+	}
+	resp := &proto.CreateChatResponse{Chat: toProtoChat(chat)}
+	for _, id := range restricted {
+		resp.RestrictedUserIds = append(resp.RestrictedUserIds, id.String())
+	}
+	return resp, nil
+}
+
+func (h *ChatHandler) SendMessage(ctx context.Context, req *proto.SendMessageRequest) (*proto.SendMessageResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	msg, duplicate, err := h.service.SendMessage(ctx, userID, service.SendMessageInput{
+		ChatID:           req.ChatId,
+		ClientMessageID:  req.ClientMessageId,
+		ContentType:      domain.ContentType(req.ContentType),
+		Content:          req.Content,
+		MediaURL:         req.MediaUrl,
+		MediaMetadata:    []byte(req.MediaMetadata),
+		ReplyToMessageID: req.ReplyToMessageId,
+	})
+	if err != nil {
+		return nil, toStatus("send message", err)
+	}
+	return &proto.SendMessageResponse{Message: toProtoMessage(msg), Duplicate: duplicate}, nil
+}
+
+func (h *ChatHandler) EditMessage(ctx context.Context, req *proto.EditMessageRequest) (*proto.EditMessageResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	msg, err := h.service.EditMessage(ctx, userID, req.MessageId, req.Content)
+	if err != nil {
+		return nil, toStatus("edit message", err)
+	}
+	return &proto.EditMessageResponse{Message: toProtoMessage(msg)}, nil
+}
+
+func (h *ChatHandler) DeleteMessage(ctx context.Context, req *proto.DeleteMessageRequest) (*proto.DeleteMessageResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.service.DeleteMessage(ctx, userID, req.MessageId, req.ForEveryone); err != nil {
+		return nil, toStatus("delete message", err)
+	}
+	return &proto.DeleteMessageResponse{Success: true}, nil
+}
+
+func (h *ChatHandler) GetMessages(ctx context.Context, req *proto.GetMessagesRequest) (*proto.GetMessagesResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	msgs, next, err := h.service.GetMessages(ctx, userID, req.ChatId, req.Cursor, int(req.Limit))
+	if err != nil {
+		return nil, toStatus("get messages", err)
+	}
+	resp := &proto.GetMessagesResponse{NextCursor: next}
+	for _, v := range msgs {
+		resp.Messages = append(resp.Messages, toProtoMessageView(v))
+	}
+	return resp, nil
+}
+
+func (h *ChatHandler) ForwardMessages(ctx context.Context, req *proto.ForwardMessagesRequest) (*proto.ForwardMessagesResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	msgs, err := h.service.ForwardMessages(ctx, userID, req.MessageIds, req.ChatIds)
+	if err != nil {
+		return nil, toStatus("forward messages", err)
+	}
+	resp := &proto.ForwardMessagesResponse{}
+	for _, m := range msgs {
+		resp.Messages = append(resp.Messages, toProtoMessage(m))
+	}
+	return resp, nil
+}
+
+func (h *ChatHandler) SetMessageTimer(ctx context.Context, req *proto.SetMessageTimerRequest) (*proto.SetMessageTimerResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	chat, err := h.service.SetMessageTimer(ctx, userID, req.ChatId, req.Timer)
+	if err != nil {
+		return nil, toStatus("set message timer", err)
+	}
+	return &proto.SetMessageTimerResponse{Chat: toProtoChat(chat)}, nil
+}
+
+func (h *ChatHandler) SetChatMuted(ctx context.Context, req *proto.SetChatMutedRequest) (*proto.ChatPreferencesResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	member, err := h.service.SetChatMuted(ctx, userID, req.ChatId, req.Muted, req.Duration)
+	if err != nil {
+		return nil, toStatus("set chat muted", err)
+	}
+	return &proto.ChatPreferencesResponse{Preferences: toProtoChatPreferences(member)}, nil
+}
+
+func (h *ChatHandler) SetChatArchived(ctx context.Context, req *proto.SetChatArchivedRequest) (*proto.ChatPreferencesResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	member, err := h.service.SetChatArchived(ctx, userID, req.ChatId, req.Archived, req.UnarchiveOnMessage)
+	if err != nil {
+		return nil, toStatus("set chat archived", err)
+	}
+	return &proto.ChatPreferencesResponse{Preferences: toProtoChatPreferences(member)}, nil
+}
+
+func (h *ChatHandler) SetChatUnread(ctx context.Context, req *proto.SetChatUnreadRequest) (*proto.ChatPreferencesResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	member, err := h.service.SetChatUnread(ctx, userID, req.ChatId, req.Unread)
+	if err != nil {
+		return nil, toStatus("set chat unread", err)
+	}
+	return &proto.ChatPreferencesResponse{Preferences: toProtoChatPreferences(member)}, nil
+}
+
+func (h *ChatHandler) SetChatPinned(ctx context.Context, req *proto.SetChatPinnedRequest) (*proto.ChatPreferencesResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	member, err := h.service.SetChatPinned(ctx, userID, req.ChatId, req.Pinned)
+	if err != nil {
+		return nil, toStatus("set chat pinned", err)
+	}
+	return &proto.ChatPreferencesResponse{Preferences: toProtoChatPreferences(member)}, nil
+}
+
+func (h *ChatHandler) AddMembers(ctx context.Context, req *proto.AddMembersRequest) (*proto.AddMembersResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	added, restricted, err := h.service.AddMembers(ctx, userID, req.ChatId, req.UserIds)
+	if err != nil {
+		return nil, toStatus("add members", err)
+	}
+	resp := &proto.AddMembersResponse{}
+	for _, id := range added {
+		resp.AddedUserIds = append(resp.AddedUserIds, id.String())
+	}
+	for _, id := range restricted {
+		resp.RestrictedUserIds = append(resp.RestrictedUserIds, id.String())
+	}
+	return resp, nil
+}
+
+func (h *ChatHandler) RemoveMember(ctx context.Context, req *proto.GroupMemberRequest) (*proto.GroupActionResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.service.RemoveMember(ctx, userID, req.ChatId, req.UserId); err != nil {
+		return nil, toStatus("remove member", err)
+	}
+	return &proto.GroupActionResponse{Success: true}, nil
+}
+
+func (h *ChatHandler) PromoteToAdmin(ctx context.Context, req *proto.GroupMemberRequest) (*proto.GroupActionResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.service.PromoteToAdmin(ctx, userID, req.ChatId, req.UserId); err != nil {
+		return nil, toStatus("promote admin", err)
+	}
+	return &proto.GroupActionResponse{Success: true}, nil
+}
+
+func (h *ChatHandler) DemoteAdmin(ctx context.Context, req *proto.GroupMemberRequest) (*proto.GroupActionResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.service.DemoteAdmin(ctx, userID, req.ChatId, req.UserId); err != nil {
+		return nil, toStatus("demote admin", err)
+	}
+	return &proto.GroupActionResponse{Success: true}, nil
+}
+
+func (h *ChatHandler) LeaveGroup(ctx context.Context, req *proto.LeaveGroupRequest) (*proto.GroupActionResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.service.LeaveGroup(ctx, userID, req.ChatId); err != nil {
+		return nil, toStatus("leave group", err)
+	}
+	return &proto.GroupActionResponse{Success: true}, nil
+}
+
+func (h *ChatHandler) UpdateGroupInfo(ctx context.Context, req *proto.UpdateGroupInfoRequest) (*proto.UpdateGroupInfoResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	chat, err := h.service.UpdateGroupInfo(ctx, userID, req.ChatId, service.GroupInfoUpdate{
+		Name:        req.Name,
+		IconURL:     req.IconUrl,
+		Description: req.Description,
+	})
+	if err != nil {
+		return nil, toStatus("update group info", err)
+	}
+	return &proto.UpdateGroupInfoResponse{Chat: toProtoChat(chat)}, nil
+}
+
+func (h *ChatHandler) GetInviteLink(ctx context.Context, req *proto.InviteLinkRequest) (*proto.InviteLinkResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	link, err := h.service.GetInviteLink(ctx, userID, req.ChatId)
+	if err != nil {
+		return nil, toStatus("get invite link", err)
+	}
+	return h.toProtoInviteLink(link), nil
+}
+
+func (h *ChatHandler) ResetInviteLink(ctx context.Context, req *proto.InviteLinkRequest) (*proto.InviteLinkResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	link, err := h.service.ResetInviteLink(ctx, userID, req.ChatId)
+	if err != nil {
+		return nil, toStatus("reset invite link", err)
+	}
+	return h.toProtoInviteLink(link), nil
+}
+
+func (h *ChatHandler) SetJoinApprovalRequired(ctx context.Context, req *proto.SetJoinApprovalRequiredRequest) (*proto.InviteLinkResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	link, err := h.service.SetJoinApprovalRequired(ctx, userID, req.ChatId, req.ApprovalRequired)
+	if err != nil {
+		return nil, toStatus("set join approval", err)
+	}
+	return h.toProtoInviteLink(link), nil
+}
+
+func (h *ChatHandler) JoinByInviteLink(ctx context.Context, req *proto.JoinByInviteLinkRequest) (*proto.JoinByInviteLinkResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res, err := h.service.JoinByInviteLink(ctx, userID, req.Code)
+	if err != nil {
+		return nil, toStatus("join by invite link", err)
+	}
+	return &proto.JoinByInviteLinkResponse{Chat: toProtoChat(res.Chat), PendingApproval: res.Pending}, nil
+}
+
+func (h *ChatHandler) ListJoinRequests(ctx context.Context, req *proto.ListJoinRequestsRequest) (*proto.ListJoinRequestsResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reqs, err := h.service.ListJoinRequests(ctx, userID, req.ChatId)
+	if err != nil {
+		return nil, toStatus("list join requests", err)
+	}
+	resp := &proto.ListJoinRequestsResponse{}
+	for _, r := range reqs {
+		resp.Requests = append(resp.Requests, &proto.JoinRequest{UserId: r.UserID.String(), RequestedAt: formatTime(r.RequestedAt)})
+	}
+	return resp, nil
+}
+
+func (h *ChatHandler) ResolveJoinRequest(ctx context.Context, req *proto.ResolveJoinRequestRequest) (*proto.GroupActionResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.service.ResolveJoinRequest(ctx, userID, req.ChatId, req.UserId, req.Approve); err != nil {
+		return nil, toStatus("resolve join request", err)
+	}
+	return &proto.GroupActionResponse{Success: true}, nil
+}
+
+func (h *ChatHandler) MarkDelivered(ctx context.Context, req *proto.MarkDeliveredRequest) (*proto.ReceiptResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	n, err := h.service.MarkDelivered(ctx, userID, req.MessageIds)
+	if err != nil {
+		return nil, toStatus("mark delivered", err)
+	}
+	return &proto.ReceiptResponse{UpdatedCount: int32(n)}, nil
+}
+
+func (h *ChatHandler) MarkRead(ctx context.Context, req *proto.MarkReadRequest) (*proto.ReceiptResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	n, err := h.service.MarkRead(ctx, userID, req.ChatId, req.UpToMessageId)
+	if err != nil {
+		return nil, toStatus("mark read", err)
+	}
+	return &proto.ReceiptResponse{UpdatedCount: int32(n)}, nil
+}
+
+func (h *ChatHandler) GetMessageInfo(ctx context.Context, req *proto.GetMessageInfoRequest) (*proto.GetMessageInfoResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	msg, statuses, err := h.service.GetMessageInfo(ctx, userID, req.MessageId)
+	if err != nil {
+		return nil, toStatus("get message info", err)
+	}
+	resp := &proto.GetMessageInfoResponse{Message: toProtoMessage(msg)}
+	for _, st := range statuses {
+		r := &proto.MemberReceipt{UserId: st.UserID.String()}
+		if st.DeliveredAt != nil {
+			r.DeliveredAt = formatTime(*st.DeliveredAt)
+		}
+		if st.ReadAt != nil {
+			r.ReadAt = formatTime(*st.ReadAt)
+		}
+		resp.Receipts = append(resp.Receipts, r)
+	}
+	return resp, nil
+}
+
+func (h *ChatHandler) SetReaction(ctx context.Context, req *proto.SetReactionRequest) (*proto.ReactionResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.service.SetReaction(ctx, userID, req.MessageId, req.Emoji); err != nil {
+		return nil, toStatus("set reaction", err)
+	}
+	return &proto.ReactionResponse{Success: true}, nil
+}
+
+func (h *ChatHandler) RemoveReaction(ctx context.Context, req *proto.RemoveReactionRequest) (*proto.ReactionResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.service.RemoveReaction(ctx, userID, req.MessageId); err != nil {
+		return nil, toStatus("remove reaction", err)
+	}
+	return &proto.ReactionResponse{Success: true}, nil
+}
+
+func (h *ChatHandler) PinMessage(ctx context.Context, req *proto.PinMessageRequest) (*proto.PinMessageResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pin, err := h.service.PinMessage(ctx, userID, req.MessageId, req.Duration)
+	if err != nil {
+		return nil, toStatus("pin message", err)
+	}
+	return &proto.PinMessageResponse{
+		ChatId:    pin.ChatID.String(),
+		MessageId: pin.MessageID,
+		PinnedAt:  formatTime(pin.PinnedAt),
+		ExpiresAt: formatTime(pin.ExpiresAt),
+	}, nil
+}
+
+func (h *ChatHandler) UnpinMessage(ctx context.Context, req *proto.UnpinMessageRequest) (*proto.UnpinMessageResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.service.UnpinMessage(ctx, userID, req.MessageId); err != nil {
+		return nil, toStatus("unpin message", err)
+	}
+	return &proto.UnpinMessageResponse{Success: true}, nil
+}
+
+func (h *ChatHandler) CreatePoll(ctx context.Context, req *proto.CreatePollRequest) (*proto.CreatePollResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	msg, results, err := h.service.CreatePoll(ctx, userID, service.CreatePollInput{
+		ChatID:               req.ChatId,
+		Question:             req.Question,
+		Options:              req.Options,
+		AllowMultipleAnswers: req.AllowMultipleAnswers,
+	})
+	if err != nil {
+		return nil, toStatus("create poll", err)
+	}
+	return &proto.CreatePollResponse{Message: toProtoMessage(msg), Poll: toProtoPoll(results)}, nil
+}
+
+func (h *ChatHandler) Vote(ctx context.Context, req *proto.VoteRequest) (*proto.PollResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	results, err := h.service.Vote(ctx, userID, req.PollId, req.OptionIds)
+	if err != nil {
+		return nil, toStatus("vote", err)
+	}
+	return &proto.PollResponse{Poll: toProtoPoll(results)}, nil
+}
+
+func (h *ChatHandler) RetractVote(ctx context.Context, req *proto.PollRequest) (*proto.PollResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	results, err := h.service.RetractVote(ctx, userID, req.PollId)
+	if err != nil {
+		return nil, toStatus("retract vote", err)
+	}
+	return &proto.PollResponse{Poll: toProtoPoll(results)}, nil
+}
+
+func (h *ChatHandler) GetPollResults(ctx context.Context, req *proto.PollRequest) (*proto.PollResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	results, err := h.service.GetPollResults(ctx, userID, req.PollId)
+	if err != nil {
+		return nil, toStatus("get poll results", err)
+	}
+	return &proto.PollResponse{Poll: toProtoPoll(results)}, nil
+}
+
+func (h *ChatHandler) ClosePoll(ctx context.Context, req *proto.PollRequest) (*proto.PollResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	results, err := h.service.ClosePoll(ctx, userID, req.PollId)
+	if err != nil {
+		return nil, toStatus("close poll", err)
+	}
+	return &proto.PollResponse{Poll: toProtoPoll(results)}, nil
+}
+
+func (h *ChatHandler) StartCall(ctx context.Context, req *proto.StartCallRequest) (*proto.CallResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	call, err := h.service.StartCall(ctx, userID, req.ChatId, domain.CallType(req.CallType))
+	if err != nil {
+		return nil, toStatus("start call", err)
+	}
+	return &proto.CallResponse{Call: toProtoCall(call)}, nil
+}
+
+func (h *ChatHandler) EndCall(ctx context.Context, req *proto.EndCallRequest) (*proto.CallResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	call, err := h.service.EndCall(ctx, userID, req.CallId, domain.CallStatus(req.Status))
+	if err != nil {
+		return nil, toStatus("end call", err)
+	}
+	return &proto.CallResponse{Call: toProtoCall(call)}, nil
+}
+
+func (h *ChatHandler) SearchMessages(ctx context.Context, req *proto.SearchMessagesRequest) (*proto.SearchMessagesResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	from, err := parseOptionalTime("from", req.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := parseOptionalTime("to", req.To)
+	if err != nil {
+		return nil, err
+	}
+	in := service.SearchInput{
+		Query:    req.Query,
+		ChatID:   req.ChatId,
+		SenderID: req.SenderId,
+		From:     from,
+		To:       to,
+		Cursor:   req.Cursor,
+		Limit:    int(req.Limit),
+	}
+	for _, ct := range req.ContentTypes {
+		in.ContentTypes = append(in.ContentTypes, domain.ContentType(ct))
+	}
+	hits, next, err := h.service.SearchMessages(ctx, userID, in)
+	if err != nil {
+		return nil, toStatus("search messages", err)
+	}
+	resp := &proto.SearchMessagesResponse{NextCursor: next}
+	for _, hit := range hits {
+		resp.Results = append(resp.Results, &proto.SearchResult{Message: toProtoMessage(hit.Message), Snippet: hit.Snippet})
+	}
+	return resp, nil
+}
+
+func (h *ChatHandler) toProtoInviteLink(link *domain.GroupInviteLink) *proto.InviteLinkResponse {
+	return &proto.InviteLinkResponse{
+		ChatId:           link.ChatID.String(),
+		Code:             link.Code,
+		Link:             h.service.InviteURL(link.Code),
+		ApprovalRequired: link.ApprovalRequired,
+	}
+}
+
+// callerID returns the authenticated user injected by the auth interceptor.
+func callerID(ctx context.Context) (string, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || userID == "" {
+		return "", status.Error(codes.Unauthenticated, "missing authenticated user")
+	}
+	return userID, nil
+}
+
+// toStatus maps service errors to gRPC status codes; unknown errors become Internal.
+func toStatus(op string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidArgument):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrChatNotFound), errors.Is(err, service.ErrMessageNotFound), errors.Is(err, service.ErrMemberNotFound),
+		errors.Is(err, service.ErrInviteNotFound), errors.Is(err, service.ErrJoinNotFound), errors.Is(err, service.ErrPollNotFound),
+		errors.Is(err, service.ErrCallNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrNotChatMember), errors.Is(err, service.ErrNotMessageSender), errors.Is(err, service.ErrNotGroupAdmin),
+		errors.Is(err, service.ErrBlocked), errors.Is(err, service.ErrNotPollCreator):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, service.ErrMessageNotEditable), errors.Is(err, service.ErrEditWindowExpired),
+		errors.Is(err, service.ErrNotGroupChat), errors.Is(err, service.ErrLastAdmin), errors.Is(err, service.ErrPollClosed),
+		errors.Is(err, service.ErrForwardLimit), errors.Is(err, service.ErrChatPinLimit):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	log.Printf("%s failed: %v", op, err)
+	return status.Errorf(codes.Internal, "failed to %s", op)
+}
+
+func toProtoChat(chat *domain.Chat) *proto.Chat {
+	pc := &proto.Chat{
+		Id:                  chat.ID.String(),
+		Type:                string(chat.Type),
+		LastMessageAt:       formatTime(chat.LastMessageAt),
+		MessageTimerSeconds: int32(chat.MessageTimerSeconds),
+	}
+	if chat.GroupName != nil {
+		pc.Name = *chat.GroupName
+	}
+	return pc
+}
+
+func toProtoChatSummary(cs *repository.ChatSummary) *proto.Chat {
+	pc := &proto.Chat{
+		Id:                  cs.Chat.ID.String(),
+		Name:                cs.Title,
+		Type:                string(cs.Chat.Type),
+		LastMessageAt:       formatTime(cs.Chat.LastMessageAt),
+		UnreadCount:         int32(cs.Member.UnreadCount),
+		MessageTimerSeconds: int32(cs.Chat.MessageTimerSeconds),
+		Preferences:         toProtoChatPreferences(&cs.Member),
+	}
+	if cs.LastMessage != nil {
+		pc.LastMessage = cs.LastMessage.Content
+	}
+	for _, ap := range cs.Pins {
+		pc.PinnedMessages = append(pc.PinnedMessages, &proto.PinnedMessage{
+			Message:        toProtoMessage(ap.Message),
+			PinnedByUserId: ap.Pin.PinnedByUserID.String(),
+			PinnedAt:       formatTime(ap.Pin.PinnedAt),
+			ExpiresAt:      formatTime(ap.Pin.ExpiresAt),
+		})
+	}
+	return pc
+}
+
+func toProtoChatPreferences(m *domain.ChatMember) *proto.ChatPreferences {
+	p := &proto.ChatPreferences{
+		ChatId:             m.ChatID.String(),
+		IsMuted:            m.IsMuted,
+		IsArchived:         m.IsArchived,
+		UnarchiveOnMessage: m.UnarchiveOnMessage,
+		MarkedUnread:       m.MarkedUnread,
+		IsPinned:           m.PinnedAt != nil,
+	}
+	if m.MutedUntil != nil {
+		p.MutedUntil = formatTime(*m.MutedUntil)
+	}
+	if m.PinnedAt != nil {
+		p.PinnedAt = formatTime(*m.PinnedAt)
+	}
+	return p
+}
+
+func toProtoMessage(m *domain.Message) *proto.Message {
+	pm := &proto.Message{
+		Id:          m.ID,
+		ChatId:      m.ChatID.String(),
+		ContentType: string(m.ContentType),
+		Content:     m.Content,
+		CreatedAt:   formatTime(m.CreatedAt),
+	}
+	if m.SenderID != nil {
+		pm.SenderId = m.SenderID.String()
+	}
+	if m.MediaURL != nil {
+		pm.MediaUrl = *m.MediaURL
+	}
+	if len(m.MediaMetadata) > 0 {
+		pm.MediaMetadata = string(m.MediaMetadata)
+	}
+	if m.ReplyToMessageID != nil {
+		pm.ReplyToMessageId = *m.ReplyToMessageID
+	}
+	if m.EditedAt != nil {
+		pm.EditedAt = formatTime(*m.EditedAt)
+	}
+	if m.DeletedAt != nil {
+		pm.DeletedAt = formatTime(*m.DeletedAt)
+	}
+	if m.DeletedByUserID != nil {
+		pm.DeletedByUserId = m.DeletedByUserID.String()
+	}
+	if m.ClientMessageID != nil {
+		pm.ClientMessageId = *m.ClientMessageID
+	}
+	pm.Forwarded = m.Forwarded
+	pm.ForwardCount = int32(m.ForwardCount)
+	if m.ExpiresAt != nil {
+		pm.ExpiresAt = formatTime(*m.ExpiresAt)
+	}
+	if m.ReplyToStatusID != nil {
+		pm.ReplyToStatusId = m.ReplyToStatusID.String()
+	}
+	if p := m.StatusPreview; p != nil {
+		pm.StatusPreview = &proto.StatusReplyPreview{
+			StatusId:    p.StatusID.String(),
+			UserId:      p.UserID.String(),
+			ContentType: string(p.ContentType),
+			Snippet:     p.Snippet,
+			MediaUrl:    p.MediaURL,
+			CreatedAt:   formatTime(p.CreatedAt),
+		}
+	}
+	return pm
+}
+
+func toProtoMessageView(v *service.MessageView) *proto.Message {
+	pm := toProtoMessage(v.Message)
+	if v.Reactions != nil {
+		for _, rc := range v.Reactions.Counts {
+			pm.Reactions = append(pm.Reactions, &proto.ReactionCount{Emoji: rc.Emoji, Count: int32(rc.Count)})
+		}
+		pm.MyReaction = v.Reactions.MyReaction
+	}
+	if rp := v.ReplyPreview; rp != nil {
+		pm.ReplyPreview = &proto.ReplyPreview{
+			MessageId:   rp.MessageID,
+			ContentType: string(rp.ContentType),
+			Snippet:     rp.Snippet,
+			Deleted:     rp.Deleted,
+		}
+		if rp.SenderID != nil {
+			pm.ReplyPreview.SenderId = rp.SenderID.String()
+		}
+	}
+	return pm
+}
+
+func toProtoPoll(r *service.PollResults) *proto.Poll {
+	pp := &proto.Poll{
+		Id:                   r.Poll.ID.String(),
+		ChatId:               r.Poll.ChatID.String(),
+		CreatedByUserId:      r.Poll.CreatedByUserID.String(),
+		Question:             r.Poll.QuestionText,
+		AllowMultipleAnswers: r.Poll.AllowMultipleAnswers,
+		CreatedAt:            formatTime(r.Poll.CreatedAt),
+		MyOptionIds:          r.MyOptionIDs,
+		TotalVoters:          int32(r.TotalVoters),
+	}
+	if r.Poll.ClosedAt != nil {
+		pp.ClosedAt = formatTime(*r.Poll.ClosedAt)
+	}
+	for _, o := range r.Options {
+		po := &proto.PollOption{Id: o.Option.ID, Text: o.Option.OptionText, VoteCount: int32(len(o.Voters))}
+		for _, v := range o.Voters {
+			po.Voters = append(po.Voters, &proto.PollVoter{UserId: v.UserID.String(), VotedAt: formatTime(v.VotedAt)})
+		}
+		pp.Options = append(pp.Options, po)
+	}
+	return pp
+}
+
+func toProtoCall(c *domain.CallLog) *proto.Call {
+	pc := &proto.Call{
+		Id:           c.ID.String(),
+		ChatId:       c.ChatID.String(),
+		CallerUserId: c.CallerUserID.String(),
+		CallType:     string(c.CallType),
+		Status:       string(c.Status),
+		StartedAt:    formatTime(c.StartedAt),
+	}
+	if c.EndedAt != nil {
+		pc.EndedAt = formatTime(*c.EndedAt)
+	}
+	return pc
+}
+
+// parseOptionalTime parses an optional RFC3339 request field.
+func parseOptionalTime(field string, v string) (*time.Time, error) {
+	if v == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s must be an RFC3339 timestamp", field)
+	}
+	return &t, nil
+}
+
+// formatTime renders timestamps as RFC3339 strings, matching the auth proto; zero times are empty.
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
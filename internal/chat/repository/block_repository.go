@@ -0,0 +1,11 @@
+package repository
+
+import "context"
+
+// BlockRepository answers questions about blocked_users relationships.
+type BlockRepository interface {
+	// IsBlockedByAnyMember reports whether any active member of the chat has blocked userID.
+	IsBlockedByAnyMember(ctx context.Context, chatID string, userID string) (bool, error)
+	// IsBlockedBetween reports whether either user has blocked the other.
+	IsBlockedBetween(ctx context.Context, userA string, userB string) (bool, error)
+}
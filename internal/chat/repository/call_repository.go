@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/dykethecreator/GoApp/pkg/domain"
+)
+
+// CallRepository defines operations on call_logs.
+type CallRepository interface {
+	Create(ctx context.Context, call *domain.CallLog) error
+	FindByID(ctx context.Context, callID string) (*domain.CallLog, error)
+	// Finish records the final status of an ongoing call and reports whether it was still ongoing.
+	Finish(ctx context.Context, callID string, status domain.CallStatus, endedAt time.Time) (bool, error)
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/dykethecreator/GoApp/pkg/domain"
+)
+
+// InviteRepository defines operations for group invite links and join requests.
+type InviteRepository interface {
+	FindByChat(ctx context.Context, chatID string) (*domain.GroupInviteLink, error)
+	FindByCode(ctx context.Context, code string) (*domain.GroupInviteLink, error)
+	// UpsertLink creates the chat's invite link or replaces its code (and settings).
+	UpsertLink(ctx context.Context, link *domain.GroupInviteLink) error
+
+	CreateJoinRequest(ctx context.Context, req *domain.GroupJoinRequest) error
+	FindJoinRequest(ctx context.Context, chatID string, userID string) (*domain.GroupJoinRequest, error)
+	ListJoinRequests(ctx context.Context, chatID string) ([]*domain.GroupJoinRequest, error)
+	DeleteJoinRequest(ctx context.Context, chatID string, userID string) error
+}
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/dykethecreator/GoApp/pkg/domain"
+)
+
+// MessageCursor identifies a position in a chat's history ordered by (created_at DESC, id DESC).
+type MessageCursor struct {
+	CreatedAt time.Time
+	ID        int64
+}
+
+// MessageSearch holds the parameters of a full-text message search. Zero-valued
+// filters are not applied.
+type MessageSearch struct {
+	Query        string
+	ChatID       string
+	SenderID     string
+	ContentTypes []domain.ContentType
+	From         *time.Time // inclusive
+	To           *time.Time // exclusive
+	Before       *MessageCursor
+	Limit        int
+}
+
+// SearchHit is a message matching a search, with a highlighted snippet of its content.
+// The snippet is HTML: the content is escaped and the matched terms are wrapped in
+// <mark></mark>.
+type SearchHit struct {
+	Message *domain.Message
+	Snippet string
+}
+
+// MessageRepository defines operations for chat messages.
+type MessageRepository interface {
+	// CreateMessage stores the message, stamps its expiry from the chat's disappearing-messages
+	// timer (system notifications never expire) and bumps the chat's last_message_at and the
+	// unread counters of the other members. If the sender already sent a message with the
+	// same ClientMessageID in this chat, the stored message is returned with created=false.
+	CreateMessage(ctx context.Context, msg *domain.Message) (stored *domain.Message, created bool, err error)
+	// CreateMessages stores msgs as CreateMessage does, in one transaction: either all of
+	// them are stored or none is. It returns the stored messages in order.
+	CreateMessages(ctx context.Context, msgs []*domain.Message) ([]*domain.Message, error)
+	FindByID(ctx context.Context, messageID int64) (*domain.Message, error)
+	// FindVisible returns those of the given messages that still exist and that userID has
+	// not deleted for themselves, in no particular order.
+	FindVisible(ctx context.Context, messageIDs []int64, userID string) ([]*domain.Message, error)
+	UpdateContent(ctx context.Context, messageID int64, content string, editedAt time.Time) error
+	// DeleteForEveryone turns the message into a DeletedContent tombstone.
+	DeleteForEveryone(ctx context.Context, messageID int64, deletedBy string, deletedAt time.Time) error
+	// DeleteForUser hides the message for a single user only.
+	DeleteForUser(ctx context.Context, messageID int64, userID string) error
+	// ListByChat returns up to limit messages visible to userID, newest first,
+	// strictly older than before when it is non-nil.
+	ListByChat(ctx context.Context, chatID string, userID string, before *MessageCursor, limit int) ([]*domain.Message, error)
+	// DeleteExpired deletes up to limit messages whose expiry is at or before now for
+	// everyone, together with their statuses, reactions, deletions, pins and polls, and
+	// returns how many messages were deleted. The messages are kept as tombstones without
+	// a deleter, so replies still link to them. Each deletion is logged for sync and
+	// announced as a MessageDeleted event for everyone, in the same transaction.
+	DeleteExpired(ctx context.Context, now time.Time, limit int) (int, error)
+	// Search returns messages matching the query in chats userID is an active member of,
+	// newest first, excluding messages deleted for everyone or for userID.
+	Search(ctx context.Context, userID string, params MessageSearch) ([]*SearchHit, error)
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/dykethecreator/GoApp/pkg/domain"
+)
+
+// ActivePin is an unexpired pin together with the message it points at.
+type ActivePin struct {
+	Pin     domain.PinnedMessage
+	Message *domain.Message
+}
+
+// PinRepository defines operations on pinned_messages.
+type PinRepository interface {
+	// Pin pins the message, or refreshes an existing pin, then keeps only the maxPins most
+	// recently pinned unexpired pins of the chat.
+	Pin(ctx context.Context, pin *domain.PinnedMessage, maxPins int) error
+	// Unpin removes the pin and reports whether there was one.
+	Unpin(ctx context.Context, chatID string, messageID int64) (bool, error)
+	// ListActive returns the pins of each given chat that are unexpired at the given time and
+	// point at live messages, most recently pinned first, keyed by chat ID.
+	ListActive(ctx context.Context, chatIDs []string, at time.Time) (map[string][]*ActivePin, error)
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/dykethecreator/GoApp/pkg/domain"
+)
+
+// PollRepository defines operations on polls, their options and votes.
+type PollRepository interface {
+	// Create stores the poll, whose ID the caller sets, and its options, in order, filling
+	// in the option IDs. msg, the PollContent message announcing the poll, is posted in the
+	// same transaction and returned as stored.
+	Create(ctx context.Context, poll *domain.Poll, options []*domain.PollOption, msg *domain.Message) (*domain.Message, error)
+	FindByID(ctx context.Context, pollID string) (*domain.Poll, error)
+	ListOptions(ctx context.Context, pollID string) ([]*domain.PollOption, error)
+	// ListVotes returns every vote of the poll, earliest first.
+	ListVotes(ctx context.Context, pollID string) ([]*domain.PollVote, error)
+	// ReplaceVotes sets the user's chosen options, replacing any earlier choice.
+	ReplaceVotes(ctx context.Context, pollID string, userID string, optionIDs []int64, at time.Time) error
+	// DeleteVotes removes the user's votes and reports whether there were any.
+	DeleteVotes(ctx context.Context, pollID string, userID string) (bool, error)
+	// Close stops voting on behalf of userID and reports whether the poll was still open.
+	Close(ctx context.Context, pollID string, userID string, at time.Time) (bool, error)
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/dykethecreator/GoApp/pkg/domain"
+)
+
+// PrivacyRepository reads users' privacy settings. They are edited through the auth service.
+type PrivacyRepository interface {
+	// Find returns userID's privacy settings, or the defaults when they never changed them.
+	Find(ctx context.Context, userID string) (*domain.PrivacySettings, error)
+	// IsContact reports whether ownerID has userID in their synced contacts.
+	IsContact(ctx context.Context, ownerID string, userID string) (bool, error)
+}
@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/dykethecreator/GoApp/pkg/domain"
+)
+
+// ReactionCount is how many users reacted to a message with one emoji.
+type ReactionCount struct {
+	Emoji string
+	Count int
+}
+
+// MessageReactions aggregates the reactions of a single message for one reader.
+type MessageReactions struct {
+	Counts     []ReactionCount // most used first
+	MyReaction string          // the reader's own reaction, empty if none
+}
+
+// ReactionRepository defines operations on message_reactions (one reaction per user per message).
+type ReactionRepository interface {
+	// Upsert sets the user's reaction, replacing any previous one.
+	Upsert(ctx context.Context, reaction *domain.MessageReaction) error
+	// Delete removes the user's reaction and reports whether there was one.
+	Delete(ctx context.Context, messageID int64, userID string) (bool, error)
+	// Aggregate returns the reactions of each given message that has any, keyed by message ID.
+	Aggregate(ctx context.Context, messageIDs []int64, readerID string) (map[int64]*MessageReactions, error)
+}
@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/google/uuid"
+)
+
+// ReceiptUpdate is a message whose status changed for the reader, with the
+// chat and sender needed to route the receipt.
+type ReceiptUpdate struct {
+	MessageID int64
+	ChatID    uuid.UUID
+	SenderID  *uuid.UUID
+}
+
+// ReceiptRepository defines operations on message_statuses.
+type ReceiptRepository interface {
+	// MarkDelivered records delivery to userID of the given messages from chats the user is
+	// active in, skipping messages the user cannot see (their own, system notifications,
+	// deleted, expired or hidden ones, and ones sent before they joined) and ones already
+	// delivered or read.
+	MarkDelivered(ctx context.Context, userID string, messageIDs []int64, at time.Time) ([]ReceiptUpdate, error)
+	// MarkReadUpTo records that userID read every message they can see in the chat up to and
+	// including upToMessageID, recounts the member's unread_count from the messages after it
+	// that are still unread and clears a "mark as unread" flag.
+	MarkReadUpTo(ctx context.Context, userID string, chatID string, upToMessageID int64, at time.Time) ([]ReceiptUpdate, error)
+	// ListForMessage returns one entry per active member of the message's chat other than the
+	// sender; members without a status have an empty Status and nil timestamps.
+	ListForMessage(ctx context.Context, messageID int64) ([]*domain.MessageStatus, error)
+}
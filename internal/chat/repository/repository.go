@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/dykethecreator/GoApp/pkg/domain"
+)
+
+// ErrLastAdmin is returned when a change would leave a group without an admin.
+var ErrLastAdmin = errors.New("the group must keep at least one admin")
+
+// ChatSummary is a chat as seen from one member's chat list.
+type ChatSummary struct {
+	Chat        domain.Chat
+	Member      domain.ChatMember
+	Title       string          // group name, or the other participant's display name
+	LastMessage *domain.Message // nil when the chat has no visible messages
+	Pins        []*ActivePin    // filled by the service, not by ListForUser
+}
+
+// ChatListFilter selects which part of a member's chat list ListForUser returns.
+type ChatListFilter string
+
+const (
+	InboxChats    ChatListFilter = "inbox" // chats that are not archived
+	ArchivedChats ChatListFilter = "archived"
+	AllChats      ChatListFilter = "all"
+)
+
+// ChatRepository defines the interface for chat and membership data operations.
+type ChatRepository interface {
+	CreateChat(ctx context.Context, chat *domain.Chat, members []domain.ChatMember) (*domain.Chat, error)
+	FindByID(ctx context.Context, chatID string) (*domain.Chat, error)
+	FindOneToOne(ctx context.Context, userA string, userB string) (*domain.Chat, error)
+	FindMember(ctx context.Context, chatID string, userID string) (*domain.ChatMember, error)
+	ListForUser(ctx context.Context, userID string, filter ChatListFilter) ([]*ChatSummary, error)
+
+	// ListActiveMembers returns the active members of a chat, longest-standing first.
+	ListActiveMembers(ctx context.Context, chatID string) ([]*domain.ChatMember, error)
+	// AddMember inserts a membership, or reactivates one that previously left or was kicked;
+	// a reactivated member starts over with default mute, archive, pin and unread state.
+	// An already active membership is left unchanged and AddMember reports false.
+	// addedBy is the user who added member, or empty when they joined on their own.
+	AddMember(ctx context.Context, member *domain.ChatMember, addedBy string) (bool, error)
+	UpdateMemberRole(ctx context.Context, chatID string, userID string, role domain.ChatMemberRole) error
+	UpdateMemberStatus(ctx context.Context, chatID string, userID string, status domain.MembershipStatus) error
+	// DemoteAdmin makes an active admin a plain member and reports whether they were one.
+	// It fails with ErrLastAdmin, changing nothing, when they are the chat's only admin.
+	// The chat's member rows stay locked from the check to the update.
+	DemoteAdmin(ctx context.Context, chatID string, userID string) (bool, error)
+	// RemoveMember sets an active member's status to left or kicked. When that leaves the
+	// chat with active members but no admin, the longest-standing remaining member is
+	// promoted in the same transaction and returned.
+	RemoveMember(ctx context.Context, chatID string, userID string, status domain.MembershipStatus) (*domain.ChatMember, error)
+	// UpdateGroupInfo sets the non-nil fields among name, icon URL and description.
+	UpdateGroupInfo(ctx context.Context, chatID string, name, iconURL, description *string) error
+	// UpdateMessageTimer sets the disappearing-messages timer; 0 turns it off.
+	UpdateMessageTimer(ctx context.Context, chatID string, seconds int) error
+
+	// UpdateMute mutes or unmutes the chat for the member; a nil until mutes indefinitely.
+	UpdateMute(ctx context.Context, chatID string, userID string, muted bool, until *time.Time) error
+	// UpdateArchived archives or unarchives the chat for the member; archiving also unpins it.
+	UpdateArchived(ctx context.Context, chatID string, userID string, archived bool, unarchiveOnMessage bool) error
+	UpdateMarkedUnread(ctx context.Context, chatID string, userID string, unread bool) error
+	// PinChat pins the chat to the top of the member's list and unarchives it. It returns
+	// false, leaving the chat unpinned, when maxPinned other chats are already pinned.
+	PinChat(ctx context.Context, chatID string, userID string, at time.Time, maxPinned int) (bool, error)
+	UnpinChat(ctx context.Context, chatID string, userID string) error
+}
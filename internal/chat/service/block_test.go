@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/dykethecreator/GoApp/internal/chat/repository"
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/google/uuid"
+)
+
+// blockPairs is a BlockRepository over fixed blocks, each stored as blocker and blocked.
+type blockPairs map[[2]string]bool
+
+func (b blockPairs) IsBlockedByAnyMember(ctx context.Context, chatID string, userID string) (bool, error) {
+	for pair := range b {
+		if pair[1] == userID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (b blockPairs) IsBlockedBetween(ctx context.Context, userA string, userB string) (bool, error) {
+	return b[[2]string{userA, userB}] || b[[2]string{userB, userA}], nil
+}
+
+// listedChats is a ChatRepository that also lists the active members.
+type listedChats struct {
+	memberChats
+}
+
+func (r *listedChats) ListActiveMembers(ctx context.Context, chatID string) ([]*domain.ChatMember, error) {
+	var out []*domain.ChatMember
+	for _, m := range r.members {
+		if m.MembershipStatus == domain.ActiveMembership {
+			out = append(out, m)
+		}
+	}
+	return out, nil
+}
+
+// defaultPrivacy is a PrivacyRepository in which nobody changed their settings.
+type defaultPrivacy struct {
+	repository.PrivacyRepository
+}
+
+func (defaultPrivacy) Find(ctx context.Context, userID string) (*domain.PrivacySettings, error) {
+	return domain.DefaultPrivacySettings(uuid.MustParse(userID)), nil
+}
+
+func TestEnsureDirectChatUnblocked(t *testing.T) {
+	alice, bob := uuid.New(), uuid.New()
+	members := map[uuid.UUID]*domain.ChatMember{
+		alice: {UserID: alice, MembershipStatus: domain.ActiveMembership},
+		bob:   {UserID: bob, MembershipStatus: domain.ActiveMembership},
+	}
+	tests := []struct {
+		name     string
+		chatType domain.ChatType
+		blocks   blockPairs
+		wantErr  error
+	}{
+		{name: "no block", chatType: domain.OneToOneChat},
+		{name: "caller blocked the other side", chatType: domain.OneToOneChat, blocks: blockPairs{{alice.String(), bob.String()}: true}, wantErr: ErrBlocked},
+		{name: "other side blocked the caller", chatType: domain.OneToOneChat, blocks: blockPairs{{bob.String(), alice.String()}: true}, wantErr: ErrBlocked},
+		{name: "groups ignore blocks between members", chatType: domain.GroupChat, blocks: blockPairs{{bob.String(), alice.String()}: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chats := &listedChats{memberChats{chat: &domain.Chat{ID: uuid.New(), Type: tt.chatType}, members: members}}
+			s := NewChatService(Repositories{Chats: chats, Blocks: tt.blocks}, DefaultConfig())
+			if err := s.ensureDirectChatUnblocked(context.Background(), chats.chat.ID.String(), alice.String()); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCanAddToGroupRefusesBlockedUsers(t *testing.T) {
+	adder, user := uuid.New(), uuid.New()
+	tests := []struct {
+		name   string
+		blocks blockPairs
+		want   bool
+	}{
+		{"no block", nil, true},
+		{"user blocked the adder", blockPairs{{user.String(), adder.String()}: true}, false},
+		{"adder blocked the user", blockPairs{{adder.String(), user.String()}: true}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewChatService(Repositories{Blocks: tt.blocks, Privacy: defaultPrivacy{}}, DefaultConfig())
+			ok, err := s.canAddToGroup(context.Background(), adder.String(), user.String())
+			if err != nil {
+				t.Fatalf("canAddToGroup: %v", err)
+			}
+			if ok != tt.want {
+				t.Errorf("canAddToGroup = %v, want %v", ok, tt.want)
+			}
+		})
+	}
+}
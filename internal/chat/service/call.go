@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/google/uuid"
+)
+
+// StartCall records a call attempt in a chat before it is signalled to the other members.
+// Calls in a one-to-one chat are refused when either participant has blocked the other.
+func (s *ChatService) StartCall(ctx context.Context, callerID string, chatID string, callType domain.CallType) (*domain.CallLog, error) {
+	if callType != domain.AudioCall && callType != domain.VideoCall {
+		return nil, fmt.Errorf("%w: call type must be audio or video", ErrInvalidArgument)
+	}
+	member, err := s.requireActiveMember(ctx, chatID, callerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ensureDirectChatUnblocked(ctx, chatID, callerID); err != nil {
+		return nil, err
+	}
+	call := &domain.CallLog{
+		ChatID:       member.ChatID,
+		CallerUserID: member.UserID,
+		CallType:     callType,
+		Status:       domain.OngoingCall,
+		StartedAt:    time.Now(),
+	}
+	if err := s.callRepo.Create(ctx, call); err != nil {
+		return nil, err
+	}
+	return call, nil
+}
+
+// EndCall records how an ongoing call ended. Any active member of the call's chat may end it;
+// ending a call that already ended returns it unchanged.
+func (s *ChatService) EndCall(ctx context.Context, callerID string, callID string, st domain.CallStatus) (*domain.CallLog, error) {
+	switch st {
+	case domain.CompletedCall, domain.MissedCall, domain.RejectedCall:
+	default:
+		return nil, fmt.Errorf("%w: status must be completed, missed or rejected", ErrInvalidArgument)
+	}
+	if _, err := uuid.Parse(callID); err != nil {
+		return nil, fmt.Errorf("%w: invalid call id", ErrInvalidArgument)
+	}
+	call, err := s.callRepo.FindByID(ctx, callID)
+	if err != nil {
+		return nil, err
+	}
+	if call == nil {
+		return nil, ErrCallNotFound
+	}
+	if _, err := s.requireActiveMember(ctx, call.ChatID.String(), callerID); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	ended, err := s.callRepo.Finish(ctx, callID, st, now)
+	if err != nil {
+		return nil, err
+	}
+	if ended {
+		call.Status = st
+		call.EndedAt = &now
+	}
+	return call, nil
+}
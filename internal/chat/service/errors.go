@@ -0,0 +1,27 @@
+package service
+
+import "errors"
+
+// Errors returned by ChatService. The handler maps them to gRPC status codes.
+var (
+	ErrInvalidArgument    = errors.New("invalid argument")
+	ErrChatNotFound       = errors.New("chat not found")
+	ErrNotChatMember      = errors.New("user is not an active member of the chat")
+	ErrMessageNotFound    = errors.New("message not found")
+	ErrNotMessageSender   = errors.New("only the sender can modify this message")
+	ErrMessageNotEditable = errors.New("message cannot be edited")
+	ErrEditWindowExpired  = errors.New("edit window has expired")
+	ErrNotGroupChat       = errors.New("chat is not a group")
+	ErrNotGroupAdmin      = errors.New("only group admins can do this")
+	ErrMemberNotFound     = errors.New("user is not an active member of the group")
+	ErrLastAdmin          = errors.New("the group must keep at least one admin")
+	ErrInviteNotFound     = errors.New("invite link is invalid or has been reset")
+	ErrJoinNotFound       = errors.New("join request not found")
+	ErrBlocked            = errors.New("action not allowed between blocked users")
+	ErrPollNotFound       = errors.New("poll not found")
+	ErrPollClosed         = errors.New("poll is closed")
+	ErrNotPollCreator     = errors.New("only the poll creator or a group admin can close this poll")
+	ErrForwardLimit       = errors.New("forward limit exceeded")
+	ErrCallNotFound       = errors.New("call not found")
+	ErrChatPinLimit       = errors.New("pinned chat limit reached")
+)
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/google/uuid"
+)
+
+// maxForwardMessages caps how many messages a single ForwardMessages call may copy.
+const maxForwardMessages = 30
+
+// ForwardMessages copies messages the caller can see into each of the target chats, in the
+// order given, marking the copies as forwarded. A call may reach at most MaxForwardChats
+// chats, or FrequentlyForwardedMaxChats when any message has already been forwarded
+// FrequentlyForwardedThreshold times.
+func (s *ChatService) ForwardMessages(ctx context.Context, callerID string, messageIDs []int64, chatIDs []string) ([]*domain.Message, error) {
+	if len(messageIDs) == 0 || len(chatIDs) == 0 {
+		return nil, fmt.Errorf("%w: message_ids and chat_ids are required", ErrInvalidArgument)
+	}
+	if len(messageIDs) > maxForwardMessages {
+		return nil, fmt.Errorf("%w: at most %d messages per call", ErrInvalidArgument, maxForwardMessages)
+	}
+	caller, err := uuid.Parse(callerID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid caller id", ErrInvalidArgument)
+	}
+
+	found, err := s.messageRepo.FindVisible(ctx, messageIDs, callerID)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int64]*domain.Message, len(found))
+	for _, m := range found {
+		byID[m.ID] = m
+	}
+	sourceChats := map[uuid.UUID]bool{}
+	frequent := false
+	var sources []*domain.Message
+	for _, id := range messageIDs {
+		m, ok := byID[id]
+		if !ok {
+			return nil, ErrMessageNotFound
+		}
+		if !sourceChats[m.ChatID] {
+			if _, err := s.requireActiveMember(ctx, m.ChatID.String(), callerID); err != nil {
+				return nil, err
+			}
+			sourceChats[m.ChatID] = true
+		}
+		switch m.ContentType {
+		case domain.TextContent, domain.ImageContent, domain.VideoContent, domain.AudioContent, domain.FileContent:
+		default:
+			return nil, fmt.Errorf("%w: %s messages cannot be forwarded", ErrInvalidArgument, m.ContentType)
+		}
+		if m.DeletedAt != nil {
+			return nil, fmt.Errorf("%w: deleted messages cannot be forwarded", ErrInvalidArgument)
+		}
+		if s.cfg.FrequentlyForwardedThreshold > 0 && m.ForwardCount >= s.cfg.FrequentlyForwardedThreshold {
+			frequent = true
+		}
+		sources = append(sources, m)
+	}
+
+	seen := map[string]bool{}
+	var targets []uuid.UUID
+	for _, id := range chatIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if _, err := s.requireActiveMember(ctx, id, callerID); err != nil {
+			return nil, err
+		}
+		if err := s.ensureDirectChatUnblocked(ctx, id, callerID); err != nil {
+			return nil, err
+		}
+		chatID, _ := uuid.Parse(id)
+		targets = append(targets, chatID)
+	}
+	limit := s.cfg.MaxForwardChats
+	if frequent {
+		limit = s.cfg.FrequentlyForwardedMaxChats
+	}
+	if limit > 0 && len(targets) > limit {
+		if frequent {
+			return nil, fmt.Errorf("%w: frequently forwarded messages can be forwarded to %d chat(s) at a time", ErrForwardLimit, limit)
+		}
+		return nil, fmt.Errorf("%w: messages can be forwarded to %d chats at a time", ErrForwardLimit, limit)
+	}
+
+	// All copies are stored together, so a failure leaves none behind.
+	copies := make([]*domain.Message, 0, len(targets)*len(sources))
+	for _, chatID := range targets {
+		for _, src := range sources {
+			copies = append(copies, &domain.Message{
+				ChatID:        chatID,
+				SenderID:      &caller,
+				ContentType:   src.ContentType,
+				Content:       src.Content,
+				MediaURL:      src.MediaURL,
+				MediaMetadata: src.MediaMetadata,
+				Forwarded:     true,
+				ForwardCount:  src.ForwardCount + 1,
+			})
+		}
+	}
+	return s.messageRepo.CreateMessages(ctx, copies)
+}
@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/google/uuid"
+)
+
+func (r *storedMessages) FindVisible(ctx context.Context, messageIDs []int64, userID string) ([]*domain.Message, error) {
+	var out []*domain.Message
+	for _, id := range messageIDs {
+		if m := r.msgs[id]; m != nil {
+			out = append(out, m)
+		}
+	}
+	return out, nil
+}
+
+func TestForwardMessages(t *testing.T) {
+	sourceID, callerID := uuid.New(), uuid.New()
+	cfg := DefaultConfig()
+	url := "https://cdn/1.jpg"
+	msgs := map[int64]*domain.Message{
+		1: {ID: 1, ChatID: sourceID, ContentType: domain.TextContent, Content: "hi"},
+		2: {ID: 2, ChatID: sourceID, ContentType: domain.ImageContent, MediaURL: &url, ForwardCount: cfg.FrequentlyForwardedThreshold},
+		3: {ID: 3, ChatID: sourceID, ContentType: domain.SystemNotificationContent},
+	}
+	targets := func(n int) []string {
+		ids := make([]string, n)
+		for i := range ids {
+			ids[i] = uuid.NewString()
+		}
+		return ids
+	}
+	dup := uuid.NewString()
+	tests := []struct {
+		name     string
+		messages []int64
+		chats    []string
+		copies   int
+		wantErr  error
+	}{
+		{name: "one message to several chats", messages: []int64{1}, chats: targets(cfg.MaxForwardChats), copies: cfg.MaxForwardChats},
+		{name: "duplicate targets count once", messages: []int64{1}, chats: []string{dup, dup}, copies: 1},
+		{name: "too many chats", messages: []int64{1}, chats: targets(cfg.MaxForwardChats + 1), wantErr: ErrForwardLimit},
+		{name: "frequently forwarded message to one chat", messages: []int64{1, 2}, chats: targets(1), copies: 2},
+		{name: "frequently forwarded message to two chats", messages: []int64{1, 2}, chats: targets(2), wantErr: ErrForwardLimit},
+		{name: "system notification", messages: []int64{3}, chats: targets(1), wantErr: ErrInvalidArgument},
+		{name: "message the caller cannot see", messages: []int64{4}, chats: targets(1), wantErr: ErrMessageNotFound},
+		{name: "too many messages", messages: make([]int64, maxForwardMessages+1), chats: targets(1), wantErr: ErrInvalidArgument},
+		{name: "no targets", messages: []int64{1}, wantErr: ErrInvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chats := &memberChats{
+				chat: &domain.Chat{Type: domain.GroupChat},
+				members: map[uuid.UUID]*domain.ChatMember{
+					callerID: {UserID: callerID, MembershipStatus: domain.ActiveMembership},
+				},
+			}
+			messages := &storedMessages{msgs: msgs}
+			s := NewChatService(Repositories{Chats: chats, Messages: messages, Blocks: noBlocks{}}, cfg)
+			out, err := s.ForwardMessages(context.Background(), callerID.String(), tt.messages, tt.chats)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if len(out) != tt.copies || len(messages.posted) != tt.copies {
+				t.Fatalf("returned %d and stored %d copies, want %d", len(out), len(messages.posted), tt.copies)
+			}
+			if tt.copies > 0 && messages.batches != 1 {
+				t.Errorf("copies stored in %d CreateMessages calls, want one", messages.batches)
+			}
+			for _, m := range messages.posted {
+				src := msgs[1]
+				if m.ContentType == domain.ImageContent {
+					src = msgs[2]
+				}
+				if !m.Forwarded || m.ForwardCount != src.ForwardCount+1 || m.SenderID == nil || *m.SenderID != callerID {
+					t.Errorf("copy = %+v, want a forwarded copy by the caller counting one more forward", m)
+				}
+			}
+		})
+	}
+}
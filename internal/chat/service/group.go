@@ -0,0 +1,314 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/dykethecreator/GoApp/internal/chat/repository"
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/google/uuid"
+)
+
+// GroupInfoUpdate holds the group fields to change; nil fields are left untouched.
+type GroupInfoUpdate struct {
+	Name        *string
+	IconURL     *string
+	Description *string
+}
+
+// AddMembers adds users to a group. Only admins may add members. Users who are already
+// active are skipped; users who previously left or were removed rejoin as plain members.
+// Users whose group-add privacy setting or a block keeps the caller from adding them are
+// skipped too. Added users' pending join requests are dropped. It returns the IDs of the users actually added and of the restricted ones.
+func (s *ChatService) AddMembers(ctx context.Context, callerID string, chatID string, userIDs []string) (added []uuid.UUID, restricted []uuid.UUID, err error) {
+	if _, err := s.requireGroupAdmin(ctx, chatID, callerID); err != nil {
+		return nil, nil, err
+	}
+	chat, _ := uuid.Parse(chatID)
+
+	seen := map[uuid.UUID]bool{}
+	for _, id := range userIDs {
+		uid, err := uuid.Parse(id)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: invalid user id %q", ErrInvalidArgument, id)
+		}
+		if seen[uid] {
+			continue
+		}
+		seen[uid] = true
+
+		existing, err := s.chatRepo.FindMember(ctx, chatID, id)
+		if err != nil {
+			return nil, nil, err
+		}
+		if existing != nil && existing.MembershipStatus == domain.ActiveMembership {
+			continue
+		}
+		ok, err := s.canAddToGroup(ctx, callerID, id)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !ok {
+			restricted = append(restricted, uid)
+			continue
+		}
+		member := &domain.ChatMember{
+			ChatID:           chat,
+			UserID:           uid,
+			Role:             domain.MemberRole,
+			MembershipStatus: domain.ActiveMembership,
+		}
+		ok, err = s.chatRepo.AddMember(ctx, member, callerID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !ok {
+			continue
+		}
+		// A pending request would otherwise be approvable after the user is already in.
+		if err := s.inviteRepo.DeleteJoinRequest(ctx, chatID, id); err != nil {
+			return nil, nil, err
+		}
+		added = append(added, uid)
+	}
+
+	if len(added) > 0 {
+		s.postSystemNotification(ctx, chat, domain.SystemNotification{
+			Event:         domain.MembersAddedEvent,
+			ActorUserID:   parseUUIDPtr(callerID),
+			TargetUserIDs: added,
+		})
+	}
+	return added, restricted, nil
+}
+
+// RemoveMember removes another member from a group. Only admins may remove members;
+// to remove yourself use LeaveGroup.
+func (s *ChatService) RemoveMember(ctx context.Context, callerID string, chatID string, userID string) error {
+	if _, err := s.requireGroupAdmin(ctx, chatID, callerID); err != nil {
+		return err
+	}
+	if userID == callerID {
+		return fmt.Errorf("%w: use LeaveGroup to leave a group", ErrInvalidArgument)
+	}
+	target, err := s.requireTargetMember(ctx, chatID, userID)
+	if err != nil {
+		return err
+	}
+	// The caller may have left meanwhile, so RemoveMember can still have to pick an admin.
+	successor, err := s.chatRepo.RemoveMember(ctx, chatID, userID, domain.KickedMembership)
+	if err != nil {
+		return err
+	}
+	s.postSystemNotification(ctx, target.ChatID, domain.SystemNotification{
+		Event:         domain.MemberRemovedEvent,
+		ActorUserID:   parseUUIDPtr(callerID),
+		TargetUserIDs: []uuid.UUID{target.UserID},
+	})
+	s.announceSuccessor(ctx, successor)
+	return nil
+}
+
+// PromoteToAdmin makes an active member a group admin. Only admins may promote.
+func (s *ChatService) PromoteToAdmin(ctx context.Context, callerID string, chatID string, userID string) error {
+	if _, err := s.requireGroupAdmin(ctx, chatID, callerID); err != nil {
+		return err
+	}
+	target, err := s.requireTargetMember(ctx, chatID, userID)
+	if err != nil {
+		return err
+	}
+	if target.Role == domain.AdminRole {
+		return nil
+	}
+	if err := s.chatRepo.UpdateMemberRole(ctx, chatID, userID, domain.AdminRole); err != nil {
+		return err
+	}
+	s.postSystemNotification(ctx, target.ChatID, domain.SystemNotification{
+		Event:         domain.AdminPromotedEvent,
+		ActorUserID:   parseUUIDPtr(callerID),
+		TargetUserIDs: []uuid.UUID{target.UserID},
+	})
+	return nil
+}
+
+// DemoteAdmin turns a group admin back into a plain member. Only admins may demote,
+// and the last remaining admin cannot be demoted.
+func (s *ChatService) DemoteAdmin(ctx context.Context, callerID string, chatID string, userID string) error {
+	if _, err := s.requireGroupAdmin(ctx, chatID, callerID); err != nil {
+		return err
+	}
+	target, err := s.requireTargetMember(ctx, chatID, userID)
+	if err != nil {
+		return err
+	}
+	if target.Role != domain.AdminRole {
+		return nil
+	}
+	demoted, err := s.chatRepo.DemoteAdmin(ctx, chatID, userID)
+	if errors.Is(err, repository.ErrLastAdmin) {
+		return ErrLastAdmin
+	}
+	if err != nil || !demoted {
+		return err
+	}
+	s.postSystemNotification(ctx, target.ChatID, domain.SystemNotification{
+		Event:         domain.AdminDemotedEvent,
+		ActorUserID:   parseUUIDPtr(callerID),
+		TargetUserIDs: []uuid.UUID{target.UserID},
+	})
+	return nil
+}
+
+// LeaveGroup removes the caller from a group. When the caller was the last admin, the
+// longest-standing remaining member is promoted so the group is never left without one.
+func (s *ChatService) LeaveGroup(ctx context.Context, callerID string, chatID string) error {
+	member, err := s.requireActiveMember(ctx, chatID, callerID)
+	if err != nil {
+		return err
+	}
+	if err := s.requireGroup(ctx, chatID); err != nil {
+		return err
+	}
+	successor, err := s.chatRepo.RemoveMember(ctx, chatID, callerID, domain.LeftMembership)
+	if err != nil {
+		return err
+	}
+	s.postSystemNotification(ctx, member.ChatID, domain.SystemNotification{
+		Event:         domain.MemberLeftEvent,
+		ActorUserID:   parseUUIDPtr(callerID),
+		TargetUserIDs: []uuid.UUID{member.UserID},
+	})
+	s.announceSuccessor(ctx, successor)
+	return nil
+}
+
+// announceSuccessor tells the group that successor, if any, was promoted to replace its
+// last admin.
+func (s *ChatService) announceSuccessor(ctx context.Context, successor *domain.ChatMember) {
+	if successor == nil {
+		return
+	}
+	// No actor: the promotion was automatic.
+	s.postSystemNotification(ctx, successor.ChatID, domain.SystemNotification{
+		Event:         domain.AdminPromotedEvent,
+		TargetUserIDs: []uuid.UUID{successor.UserID},
+	})
+}
+
+// UpdateGroupInfo changes the group's name, icon and/or description. Only admins may do so.
+func (s *ChatService) UpdateGroupInfo(ctx context.Context, callerID string, chatID string, upd GroupInfoUpdate) (*domain.Chat, error) {
+	if _, err := s.requireGroupAdmin(ctx, chatID, callerID); err != nil {
+		return nil, err
+	}
+	var changes []string
+	if upd.Name != nil {
+		name := strings.TrimSpace(*upd.Name)
+		if name == "" {
+			return nil, fmt.Errorf("%w: group name cannot be empty", ErrInvalidArgument)
+		}
+		upd.Name = &name
+		changes = append(changes, "name")
+	}
+	if upd.IconURL != nil {
+		changes = append(changes, "icon")
+	}
+	if upd.Description != nil {
+		changes = append(changes, "description")
+	}
+	if len(changes) > 0 {
+		if err := s.chatRepo.UpdateGroupInfo(ctx, chatID, upd.Name, upd.IconURL, upd.Description); err != nil {
+			return nil, err
+		}
+	}
+	chat, err := s.chatRepo.FindByID(ctx, chatID)
+	if err != nil {
+		return nil, err
+	}
+	if chat == nil {
+		return nil, ErrChatNotFound
+	}
+	if len(changes) > 0 {
+		s.postSystemNotification(ctx, chat.ID, domain.SystemNotification{
+			Event:       domain.GroupInfoUpdatedEvent,
+			ActorUserID: parseUUIDPtr(callerID),
+			Changes:     changes,
+		})
+	}
+	return chat, nil
+}
+
+// requireGroup returns ErrChatNotFound or ErrNotGroupChat unless chatID is a group.
+func (s *ChatService) requireGroup(ctx context.Context, chatID string) error {
+	chat, err := s.chatRepo.FindByID(ctx, chatID)
+	if err != nil {
+		return err
+	}
+	if chat == nil {
+		return ErrChatNotFound
+	}
+	if chat.Type != domain.GroupChat {
+		return ErrNotGroupChat
+	}
+	return nil
+}
+
+// requireGroupAdmin checks that chatID is a group in which the caller is an active admin.
+func (s *ChatService) requireGroupAdmin(ctx context.Context, chatID string, callerID string) (*domain.ChatMember, error) {
+	member, err := s.requireActiveMember(ctx, chatID, callerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireGroup(ctx, chatID); err != nil {
+		return nil, err
+	}
+	if member.Role != domain.AdminRole {
+		return nil, ErrNotGroupAdmin
+	}
+	return member, nil
+}
+
+// requireTargetMember loads the active membership of the user an admin action applies to.
+func (s *ChatService) requireTargetMember(ctx context.Context, chatID string, userID string) (*domain.ChatMember, error) {
+	if _, err := uuid.Parse(userID); err != nil {
+		return nil, fmt.Errorf("%w: invalid user id", ErrInvalidArgument)
+	}
+	target, err := s.chatRepo.FindMember(ctx, chatID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if target == nil || target.MembershipStatus != domain.ActiveMembership {
+		return nil, ErrMemberNotFound
+	}
+	return target, nil
+}
+
+// postSystemNotification inserts a SystemNotificationContent message into the chat.
+// The change it announces has already been applied, so failures are only logged.
+func (s *ChatService) postSystemNotification(ctx context.Context, chatID uuid.UUID, n domain.SystemNotification) {
+	payload, err := json.Marshal(n)
+	if err != nil {
+		log.Printf("Warning: failed to encode %s notification for chat %s: %v", n.Event, chatID, err)
+		return
+	}
+	msg := &domain.Message{
+		ChatID:      chatID,
+		ContentType: domain.SystemNotificationContent,
+		Content:     string(payload),
+	}
+	if _, _, err := s.messageRepo.CreateMessage(ctx, msg); err != nil {
+		log.Printf("Warning: failed to post %s notification in chat %s: %v", n.Event, chatID, err)
+	}
+}
+
+func parseUUIDPtr(id string) *uuid.UUID {
+	u, err := uuid.Parse(id)
+	if err != nil {
+		return nil
+	}
+	return &u
+}
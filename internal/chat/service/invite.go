@@ -0,0 +1,321 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/google/uuid"
+)
+
+// inviteCodeBytes gives 22-character codes, well inside group_invite_links.code VARCHAR(32).
+const inviteCodeBytes = 16
+
+// JoinResult describes the outcome of JoinByInviteLink.
+type JoinResult struct {
+	Chat *domain.Chat
+	// Pending is true when the group requires admin approval and a join request was queued.
+	Pending bool
+}
+
+// GetInviteLink returns the group's invite link, creating one on first use. Admins only.
+func (s *ChatService) GetInviteLink(ctx context.Context, callerID string, chatID string) (*domain.GroupInviteLink, error) {
+	if _, err := s.requireGroupAdmin(ctx, chatID, callerID); err != nil {
+		return nil, err
+	}
+	link, err := s.inviteRepo.FindByChat(ctx, chatID)
+	if err != nil {
+		return nil, err
+	}
+	if link != nil {
+		return link, nil
+	}
+	return s.issueInviteLink(ctx, callerID, chatID, false)
+}
+
+// ResetInviteLink replaces the group's invite code so the previous link stops working. Admins only.
+func (s *ChatService) ResetInviteLink(ctx context.Context, callerID string, chatID string) (*domain.GroupInviteLink, error) {
+	if _, err := s.requireGroupAdmin(ctx, chatID, callerID); err != nil {
+		return nil, err
+	}
+	current, err := s.inviteRepo.FindByChat(ctx, chatID)
+	if err != nil {
+		return nil, err
+	}
+	approval := current != nil && current.ApprovalRequired
+	link, err := s.issueInviteLink(ctx, callerID, chatID, approval)
+	if err != nil {
+		return nil, err
+	}
+	s.postSystemNotification(ctx, link.ChatID, domain.SystemNotification{
+		Event:       domain.InviteLinkResetEvent,
+		ActorUserID: parseUUIDPtr(callerID),
+	})
+	return link, nil
+}
+
+// SetJoinApprovalRequired switches whether joins through the invite link must be approved
+// by an admin. Admins only. Switching approval off admits the queued requesters, except
+// users kicked from the group or blocked by a member: those stay queued for admins.
+func (s *ChatService) SetJoinApprovalRequired(ctx context.Context, callerID string, chatID string, required bool) (*domain.GroupInviteLink, error) {
+	if _, err := s.requireGroupAdmin(ctx, chatID, callerID); err != nil {
+		return nil, err
+	}
+	link, err := s.inviteRepo.FindByChat(ctx, chatID)
+	if err != nil {
+		return nil, err
+	}
+	if link == nil {
+		return s.issueInviteLink(ctx, callerID, chatID, required)
+	}
+	wasRequired := link.ApprovalRequired
+	link.ApprovalRequired = required
+	if err := s.inviteRepo.UpsertLink(ctx, link); err != nil {
+		return nil, err
+	}
+	if wasRequired && !required {
+		if err := s.admitQueuedRequests(ctx, callerID, chatID); err != nil {
+			return nil, err
+		}
+	}
+	return link, nil
+}
+
+// admitQueuedRequests admits the group's pending requesters who could now join through
+// the link on their own.
+func (s *ChatService) admitQueuedRequests(ctx context.Context, callerID string, chatID string) error {
+	reqs, err := s.inviteRepo.ListJoinRequests(ctx, chatID)
+	if err != nil {
+		return err
+	}
+	for _, req := range reqs {
+		member, err := s.chatRepo.FindMember(ctx, chatID, req.UserID.String())
+		if err != nil {
+			return err
+		}
+		if member != nil && member.MembershipStatus == domain.KickedMembership {
+			continue
+		}
+		if err := s.admitJoinRequest(ctx, callerID, req); err != nil && !errors.Is(err, ErrBlocked) {
+			return err
+		}
+	}
+	return nil
+}
+
+// JoinByInviteLink adds the caller to the group the code belongs to, or queues a join
+// request when admin approval is required. Users kicked from the group are always queued,
+// so an admin decides whether they come back. Users blocked by any active member are refused.
+func (s *ChatService) JoinByInviteLink(ctx context.Context, callerID string, code string) (*JoinResult, error) {
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return nil, fmt.Errorf("%w: invite code is required", ErrInvalidArgument)
+	}
+	caller, err := uuid.Parse(callerID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid caller id", ErrInvalidArgument)
+	}
+	link, err := s.inviteRepo.FindByCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	if link == nil {
+		return nil, ErrInviteNotFound
+	}
+	chat, err := s.chatRepo.FindByID(ctx, link.ChatID.String())
+	if err != nil {
+		return nil, err
+	}
+	if chat == nil {
+		return nil, ErrInviteNotFound
+	}
+
+	member, err := s.chatRepo.FindMember(ctx, chat.ID.String(), callerID)
+	if err != nil {
+		return nil, err
+	}
+	if member != nil && member.MembershipStatus == domain.ActiveMembership {
+		return &JoinResult{Chat: chat}, nil
+	}
+	if err := s.ensureNotBlockedByMembers(ctx, chat.ID.String(), callerID); err != nil {
+		return nil, err
+	}
+
+	if link.ApprovalRequired || (member != nil && member.MembershipStatus == domain.KickedMembership) {
+		req := &domain.GroupJoinRequest{ChatID: chat.ID, UserID: caller}
+		if err := s.inviteRepo.CreateJoinRequest(ctx, req); err != nil {
+			return nil, err
+		}
+		return &JoinResult{Chat: chat, Pending: true}, nil
+	}
+
+	joined, err := s.chatRepo.AddMember(ctx, &domain.ChatMember{
+		ChatID:           chat.ID,
+		UserID:           caller,
+		Role:             domain.MemberRole,
+		MembershipStatus: domain.ActiveMembership,
+	}, "")
+	if err != nil {
+		return nil, err
+	}
+	if !joined {
+		return &JoinResult{Chat: chat}, nil
+	}
+	s.postSystemNotification(ctx, chat.ID, domain.SystemNotification{
+		Event:         domain.MemberJoinedEvent,
+		ActorUserID:   &caller,
+		TargetUserIDs: []uuid.UUID{caller},
+	})
+	return &JoinResult{Chat: chat}, nil
+}
+
+// ListJoinRequests returns the group's pending join requests, oldest first. Admins only.
+func (s *ChatService) ListJoinRequests(ctx context.Context, callerID string, chatID string) ([]*domain.GroupJoinRequest, error) {
+	if _, err := s.requireGroupAdmin(ctx, chatID, callerID); err != nil {
+		return nil, err
+	}
+	return s.inviteRepo.ListJoinRequests(ctx, chatID)
+}
+
+// ResolveJoinRequest approves or rejects a pending join request. Admins only.
+func (s *ChatService) ResolveJoinRequest(ctx context.Context, callerID string, chatID string, userID string, approve bool) error {
+	if _, err := s.requireGroupAdmin(ctx, chatID, callerID); err != nil {
+		return err
+	}
+	if _, err := uuid.Parse(userID); err != nil {
+		return fmt.Errorf("%w: invalid user id", ErrInvalidArgument)
+	}
+	req, err := s.inviteRepo.FindJoinRequest(ctx, chatID, userID)
+	if err != nil {
+		return err
+	}
+	if req == nil {
+		return ErrJoinNotFound
+	}
+	if approve {
+		return s.admitJoinRequest(ctx, callerID, req)
+	}
+	return s.inviteRepo.DeleteJoinRequest(ctx, chatID, userID)
+}
+
+// admitJoinRequest adds the requester to the group on actorID's behalf and drops the request.
+// A requester who has become a member some other way since asking just loses the request.
+func (s *ChatService) admitJoinRequest(ctx context.Context, actorID string, req *domain.GroupJoinRequest) error {
+	chatID, userID := req.ChatID.String(), req.UserID.String()
+	member, err := s.chatRepo.FindMember(ctx, chatID, userID)
+	if err != nil {
+		return err
+	}
+	if member != nil && member.MembershipStatus == domain.ActiveMembership {
+		return s.inviteRepo.DeleteJoinRequest(ctx, chatID, userID)
+	}
+	// A member may have blocked the requester after the request was made.
+	if err := s.ensureNotBlockedByMembers(ctx, chatID, userID); err != nil {
+		return err
+	}
+	joined, err := s.chatRepo.AddMember(ctx, &domain.ChatMember{
+		ChatID:           req.ChatID,
+		UserID:           req.UserID,
+		Role:             domain.MemberRole,
+		MembershipStatus: domain.ActiveMembership,
+	}, actorID)
+	if err != nil {
+		return err
+	}
+	if err := s.inviteRepo.DeleteJoinRequest(ctx, chatID, userID); err != nil {
+		return err
+	}
+	if !joined {
+		return nil
+	}
+	s.postSystemNotification(ctx, req.ChatID, domain.SystemNotification{
+		Event:         domain.MemberJoinedEvent,
+		ActorUserID:   parseUUIDPtr(actorID),
+		TargetUserIDs: []uuid.UUID{req.UserID},
+	})
+	return nil
+}
+
+// InviteURL renders the shareable link for an invite code.
+func (s *ChatService) InviteURL(code string) string {
+	if s.cfg.InviteLinkBaseURL == "" {
+		return code
+	}
+	return strings.TrimRight(s.cfg.InviteLinkBaseURL, "/") + "/" + code
+}
+
+func (s *ChatService) issueInviteLink(ctx context.Context, callerID string, chatID string, approvalRequired bool) (*domain.GroupInviteLink, error) {
+	code, err := newInviteCode()
+	if err != nil {
+		return nil, err
+	}
+	chat, _ := uuid.Parse(chatID)
+	creator, _ := uuid.Parse(callerID)
+	link := &domain.GroupInviteLink{
+		ChatID:           chat,
+		Code:             code,
+		ApprovalRequired: approvalRequired,
+		CreatedByUserID:  creator,
+	}
+	if err := s.inviteRepo.UpsertLink(ctx, link); err != nil {
+		return nil, err
+	}
+	return link, nil
+}
+
+// ensureNotBlockedByMembers refuses users that an active member of the chat has blocked,
+// so an invite link cannot be used to reach the blocker.
+func (s *ChatService) ensureNotBlockedByMembers(ctx context.Context, chatID string, userID string) error {
+	blocked, err := s.blockRepo.IsBlockedByAnyMember(ctx, chatID, userID)
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return ErrBlocked
+	}
+	return nil
+}
+
+// ensureDirectChatUnblocked refuses actions in a one-to-one chat when either participant
+// has blocked the other. Group chats are not affected.
+func (s *ChatService) ensureDirectChatUnblocked(ctx context.Context, chatID string, userID string) error {
+	chat, err := s.chatRepo.FindByID(ctx, chatID)
+	if err != nil {
+		return err
+	}
+	if chat == nil {
+		return ErrChatNotFound
+	}
+	if chat.Type != domain.OneToOneChat {
+		return nil
+	}
+	members, err := s.chatRepo.ListActiveMembers(ctx, chatID)
+	if err != nil {
+		return err
+	}
+	for _, m := range members {
+		if m.UserID.String() == userID {
+			continue
+		}
+		blocked, err := s.blockRepo.IsBlockedBetween(ctx, userID, m.UserID.String())
+		if err != nil {
+			return err
+		}
+		if blocked {
+			return ErrBlocked
+		}
+	}
+	return nil
+}
+
+func newInviteCode() (string, error) {
+	b := make([]byte, inviteCodeBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
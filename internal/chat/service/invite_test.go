@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/dykethecreator/GoApp/internal/chat/repository"
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/google/uuid"
+)
+
+func TestNewInviteCode(t *testing.T) {
+	seen := map[string]bool{}
+	for i := 0; i < 100; i++ {
+		code, err := newInviteCode()
+		if err != nil {
+			t.Fatalf("newInviteCode: %v", err)
+		}
+		if len(code) != 22 {
+			t.Fatalf("len(%q) = %d, want 22", code, len(code))
+		}
+		raw, err := base64.RawURLEncoding.DecodeString(code)
+		if err != nil || len(raw) != inviteCodeBytes {
+			t.Fatalf("%q is not %d bytes of URL-safe base64: %v", code, inviteCodeBytes, err)
+		}
+		if seen[code] {
+			t.Fatalf("duplicate code %q", code)
+		}
+		seen[code] = true
+	}
+}
+
+func TestInviteURL(t *testing.T) {
+	tests := []struct {
+		base string
+		want string
+	}{
+		{base: "", want: "abc"},
+		{base: "https://chat.example/join", want: "https://chat.example/join/abc"},
+		{base: "https://chat.example/join/", want: "https://chat.example/join/abc"},
+		{base: "https://chat.example/join//", want: "https://chat.example/join/abc"},
+	}
+	for _, tt := range tests {
+		s := &ChatService{cfg: Config{InviteLinkBaseURL: tt.base}}
+		if got := s.InviteURL("abc"); got != tt.want {
+			t.Errorf("InviteURL with base %q = %q, want %q", tt.base, got, tt.want)
+		}
+	}
+}
+
+// memberChats is a ChatRepository over an in-memory group; unused methods panic.
+type memberChats struct {
+	repository.ChatRepository
+	chat    *domain.Chat
+	members map[uuid.UUID]*domain.ChatMember
+	adds    int
+}
+
+func (r *memberChats) FindByID(ctx context.Context, chatID string) (*domain.Chat, error) {
+	return r.chat, nil
+}
+
+func (r *memberChats) FindMember(ctx context.Context, chatID string, userID string) (*domain.ChatMember, error) {
+	return r.members[uuid.MustParse(userID)], nil
+}
+
+func (r *memberChats) AddMember(ctx context.Context, member *domain.ChatMember, addedBy string) (bool, error) {
+	r.adds++
+	if m := r.members[member.UserID]; m != nil && m.MembershipStatus == domain.ActiveMembership {
+		return false, nil
+	}
+	r.members[member.UserID] = member
+	return true, nil
+}
+
+type joinRequests struct {
+	repository.InviteRepository
+	reqs map[uuid.UUID]*domain.GroupJoinRequest
+}
+
+func (r *joinRequests) FindJoinRequest(ctx context.Context, chatID string, userID string) (*domain.GroupJoinRequest, error) {
+	return r.reqs[uuid.MustParse(userID)], nil
+}
+
+func (r *joinRequests) DeleteJoinRequest(ctx context.Context, chatID string, userID string) error {
+	delete(r.reqs, uuid.MustParse(userID))
+	return nil
+}
+
+type noBlocks struct{}
+
+func (noBlocks) IsBlockedByAnyMember(ctx context.Context, chatID string, userID string) (bool, error) {
+	return false, nil
+}
+
+func (noBlocks) IsBlockedBetween(ctx context.Context, userA string, userB string) (bool, error) {
+	return false, nil
+}
+
+type postedMessages struct {
+	repository.MessageRepository
+	posted  []*domain.Message
+	batches int // CreateMessages calls
+}
+
+func (r *postedMessages) CreateMessage(ctx context.Context, msg *domain.Message) (*domain.Message, bool, error) {
+	r.posted = append(r.posted, msg)
+	return msg, true, nil
+}
+
+func (r *postedMessages) CreateMessages(ctx context.Context, msgs []*domain.Message) ([]*domain.Message, error) {
+	r.posted = append(r.posted, msgs...)
+	r.batches++
+	return msgs, nil
+}
+
+func TestResolveJoinRequestForActiveMember(t *testing.T) {
+	ctx := context.Background()
+	chatID, adminID, userID := uuid.New(), uuid.New(), uuid.New()
+	chats := &memberChats{
+		chat: &domain.Chat{ID: chatID, Type: domain.GroupChat},
+		members: map[uuid.UUID]*domain.ChatMember{
+			adminID: {ChatID: chatID, UserID: adminID, Role: domain.AdminRole, MembershipStatus: domain.ActiveMembership},
+			// Added directly and promoted after asking to join through the link.
+			userID: {ChatID: chatID, UserID: userID, Role: domain.AdminRole, MembershipStatus: domain.ActiveMembership},
+		},
+	}
+	invites := &joinRequests{reqs: map[uuid.UUID]*domain.GroupJoinRequest{
+		userID: {ChatID: chatID, UserID: userID},
+	}}
+	messages := &postedMessages{}
+	s := NewChatService(Repositories{Chats: chats, Invites: invites, Blocks: noBlocks{}, Messages: messages}, DefaultConfig())
+
+	if err := s.ResolveJoinRequest(ctx, adminID.String(), chatID.String(), userID.String(), true); err != nil {
+		t.Fatalf("ResolveJoinRequest: %v", err)
+	}
+	if chats.adds != 0 {
+		t.Errorf("AddMember called %d times for an active member", chats.adds)
+	}
+	if got := chats.members[userID].Role; got != domain.AdminRole {
+		t.Errorf("role = %q, want %q", got, domain.AdminRole)
+	}
+	if _, ok := invites.reqs[userID]; ok {
+		t.Error("stale join request was not deleted")
+	}
+	if len(messages.posted) != 0 {
+		t.Errorf("posted %d notifications, want none", len(messages.posted))
+	}
+}
@@ -0,0 +1,333 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dykethecreator/GoApp/internal/chat/repository"
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/google/uuid"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 100
+	// maxClientMessageIDLen matches messages.client_message_id VARCHAR(64).
+	maxClientMessageIDLen = 64
+)
+
+// MessageView is a message as shown to one reader, together with its aggregated extras.
+type MessageView struct {
+	*domain.Message
+	Reactions    *repository.MessageReactions // nil when nobody reacted
+	ReplyPreview *ReplyPreview                // nil when the message is not a reply
+}
+
+// ReplyPreview is the compact quote of the message a reply points at.
+type ReplyPreview struct {
+	MessageID   int64
+	SenderID    *uuid.UUID
+	ContentType domain.ContentType
+	Snippet     string // start of the text, caption or poll question
+	// Deleted is set when the original was deleted for everyone, deleted by the reader
+	// or no longer exists; only MessageID is filled in then.
+	Deleted bool
+}
+
+// SendMessageInput carries the fields a client may set when sending a message.
+type SendMessageInput struct {
+	ChatID           string
+	ClientMessageID  string
+	ContentType      domain.ContentType
+	Content          string
+	MediaURL         string
+	MediaMetadata    json.RawMessage
+	ReplyToMessageID int64
+	// StatusReply links the message to the status it answers; set by SendStatusReply only.
+	StatusReply *domain.StatusReplyPreview
+}
+
+// SendMessage stores a new message from the caller. When ClientMessageID matches a message
+// the caller already sent to this chat, that message is returned and duplicate is true.
+func (s *ChatService) SendMessage(ctx context.Context, callerID string, in SendMessageInput) (msg *domain.Message, duplicate bool, err error) {
+	if _, err := s.requireActiveMember(ctx, in.ChatID, callerID); err != nil {
+		return nil, false, err
+	}
+	if err := validateSendInput(in); err != nil {
+		return nil, false, err
+	}
+	if err := s.ensureDirectChatUnblocked(ctx, in.ChatID, callerID); err != nil {
+		return nil, false, err
+	}
+
+	chatID, _ := uuid.Parse(in.ChatID)
+	sender, err := uuid.Parse(callerID)
+	if err != nil {
+		return nil, false, fmt.Errorf("%w: invalid caller id", ErrInvalidArgument)
+	}
+
+	msg = &domain.Message{
+		ChatID:      chatID,
+		SenderID:    &sender,
+		ContentType: in.ContentType,
+		Content:     in.Content,
+	}
+	if in.MediaURL != "" {
+		msg.MediaURL = &in.MediaURL
+	}
+	if len(in.MediaMetadata) > 0 {
+		msg.MediaMetadata = in.MediaMetadata
+	}
+	if in.ClientMessageID != "" {
+		msg.ClientMessageID = &in.ClientMessageID
+	}
+	if in.ReplyToMessageID != 0 {
+		// Replies must point at a message in the same chat.
+		target, err := s.messageRepo.FindByID(ctx, in.ReplyToMessageID)
+		if err != nil {
+			return nil, false, err
+		}
+		if target == nil || target.ChatID != chatID {
+			return nil, false, fmt.Errorf("%w: reply target not found in chat", ErrInvalidArgument)
+		}
+		msg.ReplyToMessageID = &in.ReplyToMessageID
+	}
+	if in.StatusReply != nil {
+		statusID := in.StatusReply.StatusID
+		msg.ReplyToStatusID = &statusID
+		msg.StatusPreview = in.StatusReply
+	}
+
+	stored, created, err := s.messageRepo.CreateMessage(ctx, msg)
+	if err != nil {
+		return nil, false, err
+	}
+	return stored, !created, nil
+}
+
+func validateSendInput(in SendMessageInput) error {
+	if len(in.ClientMessageID) > maxClientMessageIDLen {
+		return fmt.Errorf("%w: client_message_id longer than %d characters", ErrInvalidArgument, maxClientMessageIDLen)
+	}
+	if len(in.MediaMetadata) > 0 && !json.Valid(in.MediaMetadata) {
+		return fmt.Errorf("%w: media_metadata must be valid JSON", ErrInvalidArgument)
+	}
+	switch in.ContentType {
+	case domain.TextContent:
+		if strings.TrimSpace(in.Content) == "" {
+			return fmt.Errorf("%w: text message content is required", ErrInvalidArgument)
+		}
+	case domain.ImageContent, domain.VideoContent, domain.AudioContent, domain.FileContent:
+		if in.MediaURL == "" {
+			return fmt.Errorf("%w: media_url is required for %s messages", ErrInvalidArgument, in.ContentType)
+		}
+	default:
+		return fmt.Errorf("%w: unsupported content type %q", ErrInvalidArgument, in.ContentType)
+	}
+	return nil
+}
+
+// EditMessage replaces the content (text or media caption) of the caller's own message
+// as long as it is still within the configured edit window.
+func (s *ChatService) EditMessage(ctx context.Context, callerID string, messageID int64, content string) (*domain.Message, error) {
+	msg, err := s.findOwnMessage(ctx, callerID, messageID)
+	if err != nil {
+		return nil, err
+	}
+	switch msg.ContentType {
+	case domain.TextContent, domain.ImageContent, domain.VideoContent, domain.FileContent:
+	default:
+		return nil, ErrMessageNotEditable
+	}
+	if msg.ContentType == domain.TextContent && strings.TrimSpace(content) == "" {
+		return nil, fmt.Errorf("%w: text message content is required", ErrInvalidArgument)
+	}
+
+	now := time.Now()
+	if s.cfg.EditWindow > 0 && now.Sub(msg.CreatedAt) > s.cfg.EditWindow {
+		return nil, ErrEditWindowExpired
+	}
+	if err := s.messageRepo.UpdateContent(ctx, messageID, content, now); err != nil {
+		return nil, err
+	}
+	msg.Content = content
+	msg.EditedAt = &now
+	return msg, nil
+}
+
+// DeleteMessage hides a message for the caller, or, when forEveryone is set, replaces it
+// with a DeletedContent tombstone for every member. Only the sender may delete for everyone.
+func (s *ChatService) DeleteMessage(ctx context.Context, callerID string, messageID int64, forEveryone bool) error {
+	if forEveryone {
+		msg, err := s.findOwnMessage(ctx, callerID, messageID)
+		if err != nil {
+			return err
+		}
+		if msg.DeletedAt != nil {
+			return nil // already deleted
+		}
+		return s.messageRepo.DeleteForEveryone(ctx, messageID, callerID, time.Now())
+	}
+
+	msg, err := s.messageRepo.FindByID(ctx, messageID)
+	if err != nil {
+		return err
+	}
+	if msg == nil {
+		return ErrMessageNotFound
+	}
+	if _, err := s.requireActiveMember(ctx, msg.ChatID.String(), callerID); err != nil {
+		return err
+	}
+	return s.messageRepo.DeleteForUser(ctx, messageID, callerID)
+}
+
+// findOwnMessage loads a live message sent by the caller in a chat the caller is still active in.
+func (s *ChatService) findOwnMessage(ctx context.Context, callerID string, messageID int64) (*domain.Message, error) {
+	msg, err := s.messageRepo.FindByID(ctx, messageID)
+	if err != nil {
+		return nil, err
+	}
+	if msg == nil {
+		return nil, ErrMessageNotFound
+	}
+	if _, err := s.requireActiveMember(ctx, msg.ChatID.String(), callerID); err != nil {
+		return nil, err
+	}
+	if msg.SenderID == nil || msg.SenderID.String() != callerID {
+		return nil, ErrNotMessageSender
+	}
+	if msg.DeletedAt != nil {
+		return nil, ErrMessageNotEditable
+	}
+	return msg, nil
+}
+
+// GetMessages returns a page of the chat's history visible to the caller, newest first,
+// with aggregated reactions. nextCursor is empty when there are no older messages.
+func (s *ChatService) GetMessages(ctx context.Context, callerID string, chatID string, cursor string, limit int) ([]*MessageView, string, error) {
+	if _, err := s.requireActiveMember(ctx, chatID, callerID); err != nil {
+		return nil, "", err
+	}
+	before, err := decodeCursor(cursor)
+	if err != nil {
+		return nil, "", err
+	}
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	// Fetch one extra row to learn whether another page exists.
+	msgs, err := s.messageRepo.ListByChat(ctx, chatID, callerID, before, limit+1)
+	if err != nil {
+		return nil, "", err
+	}
+	next := ""
+	if len(msgs) > limit {
+		msgs = msgs[:limit]
+		last := msgs[len(msgs)-1]
+		next = encodeCursor(repository.MessageCursor{CreatedAt: last.CreatedAt, ID: last.ID})
+	}
+
+	ids := make([]int64, 0, len(msgs))
+	for _, m := range msgs {
+		ids = append(ids, m.ID)
+	}
+	reactions, err := s.reactionRepo.Aggregate(ctx, ids, callerID)
+	if err != nil {
+		return nil, "", err
+	}
+	previews, err := s.replyPreviews(ctx, callerID, msgs)
+	if err != nil {
+		return nil, "", err
+	}
+	views := make([]*MessageView, 0, len(msgs))
+	for _, m := range msgs {
+		v := &MessageView{Message: m, Reactions: reactions[m.ID]}
+		if m.ReplyToMessageID != nil {
+			v.ReplyPreview = previews[*m.ReplyToMessageID]
+			if v.ReplyPreview == nil {
+				v.ReplyPreview = &ReplyPreview{MessageID: *m.ReplyToMessageID, Deleted: true}
+			}
+		}
+		views = append(views, v)
+	}
+	return views, next, nil
+}
+
+// replyPreviews loads the originals quoted by msgs that the reader can still see, keyed by ID.
+func (s *ChatService) replyPreviews(ctx context.Context, readerID string, msgs []*domain.Message) (map[int64]*ReplyPreview, error) {
+	var ids []int64
+	for _, m := range msgs {
+		if m.ReplyToMessageID != nil {
+			ids = append(ids, *m.ReplyToMessageID)
+		}
+	}
+	out := map[int64]*ReplyPreview{}
+	if len(ids) == 0 {
+		return out, nil
+	}
+	originals, err := s.messageRepo.FindVisible(ctx, ids, readerID)
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range originals {
+		if o.DeletedAt != nil || o.ContentType == domain.DeletedContent {
+			continue
+		}
+		out[o.ID] = &ReplyPreview{
+			MessageID:   o.ID,
+			SenderID:    o.SenderID,
+			ContentType: o.ContentType,
+			Snippet:     previewText(o),
+		}
+	}
+	return out, nil
+}
+
+// previewText returns the start of a message's text, caption or poll question.
+func previewText(m *domain.Message) string {
+	text := m.Content
+	if m.ContentType == domain.PollContent {
+		var p domain.PollMessage
+		if err := json.Unmarshal([]byte(m.Content), &p); err != nil {
+			return ""
+		}
+		text = p.Question
+	}
+	return domain.QuoteSnippet(text)
+}
+
+// encodeCursor renders a history position as an opaque URL-safe token.
+func encodeCursor(c repository.MessageCursor) string {
+	raw := strconv.FormatInt(c.CreatedAt.UnixNano(), 10) + ":" + strconv.FormatInt(c.ID, 10)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeCursor(cursor string) (*repository.MessageCursor, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed cursor", ErrInvalidArgument)
+	}
+	ts, id, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return nil, fmt.Errorf("%w: malformed cursor", ErrInvalidArgument)
+	}
+	nanos, err1 := strconv.ParseInt(ts, 10, 64)
+	msgID, err2 := strconv.ParseInt(id, 10, 64)
+	if err1 != nil || err2 != nil {
+		return nil, fmt.Errorf("%w: malformed cursor", ErrInvalidArgument)
+	}
+	return &repository.MessageCursor{CreatedAt: time.Unix(0, nanos), ID: msgID}, nil
+}
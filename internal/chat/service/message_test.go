@@ -0,0 +1,117 @@
+package service
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dykethecreator/GoApp/internal/chat/repository"
+	"github.com/dykethecreator/GoApp/pkg/domain"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	tests := []repository.MessageCursor{
+		{CreatedAt: time.Unix(0, 0), ID: 1},
+		{CreatedAt: time.Date(2024, 5, 1, 12, 30, 0, 123456789, time.UTC), ID: 987654321},
+		{CreatedAt: time.Unix(1700000000, 1), ID: 0},
+	}
+	for _, want := range tests {
+		token := encodeCursor(want)
+		if strings.ContainsAny(token, "+/=") {
+			t.Errorf("encodeCursor(%v) = %q, not URL-safe", want, token)
+		}
+		got, err := decodeCursor(token)
+		if err != nil {
+			t.Fatalf("decodeCursor(%q): %v", token, err)
+		}
+		if !got.CreatedAt.Equal(want.CreatedAt) || got.ID != want.ID {
+			t.Errorf("decodeCursor(encodeCursor(%v)) = %v", want, *got)
+		}
+	}
+}
+
+func TestDecodeCursor(t *testing.T) {
+	enc := func(s string) string { return base64.RawURLEncoding.EncodeToString([]byte(s)) }
+	tests := []struct {
+		name    string
+		cursor  string
+		want    *repository.MessageCursor
+		wantErr bool
+	}{
+		{name: "empty starts at the newest", cursor: "", want: nil},
+		{name: "valid", cursor: enc("1000:7"), want: &repository.MessageCursor{CreatedAt: time.Unix(0, 1000), ID: 7}},
+		{name: "not base64", cursor: "!!!", wantErr: true},
+		{name: "no separator", cursor: enc("1000"), wantErr: true},
+		{name: "bad timestamp", cursor: enc("abc:7"), wantErr: true},
+		{name: "bad id", cursor: enc("1000:x"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := decodeCursor(tt.cursor)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidArgument) {
+					t.Fatalf("err = %v, want ErrInvalidArgument", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("err = %v", err)
+			}
+			if (got == nil) != (tt.want == nil) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+			if got != nil && (!got.CreatedAt.Equal(tt.want.CreatedAt) || got.ID != tt.want.ID) {
+				t.Errorf("got %v, want %v", *got, *tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateSendInput(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      SendMessageInput
+		wantErr bool
+	}{
+		{name: "text", in: SendMessageInput{ContentType: domain.TextContent, Content: "hello"}},
+		{name: "blank text", in: SendMessageInput{ContentType: domain.TextContent, Content: "  \n"}, wantErr: true},
+		{name: "image with url", in: SendMessageInput{ContentType: domain.ImageContent, MediaURL: "https://cdn/x.jpg"}},
+		{name: "image without url", in: SendMessageInput{ContentType: domain.ImageContent}, wantErr: true},
+		{name: "file with url", in: SendMessageInput{ContentType: domain.FileContent, MediaURL: "https://cdn/x.pdf"}},
+		{name: "poll is not sent directly", in: SendMessageInput{ContentType: domain.PollContent, Content: "{}"}, wantErr: true},
+		{name: "system notification", in: SendMessageInput{ContentType: domain.SystemNotificationContent, Content: "{}"}, wantErr: true},
+		{name: "unknown type", in: SendMessageInput{ContentType: "sticker", Content: "x"}, wantErr: true},
+		{
+			name: "client message id at limit",
+			in:   SendMessageInput{ContentType: domain.TextContent, Content: "x", ClientMessageID: strings.Repeat("a", maxClientMessageIDLen)},
+		},
+		{
+			name:    "client message id too long",
+			in:      SendMessageInput{ContentType: domain.TextContent, Content: "x", ClientMessageID: strings.Repeat("a", maxClientMessageIDLen+1)},
+			wantErr: true,
+		},
+		{
+			name: "valid media metadata",
+			in:   SendMessageInput{ContentType: domain.VideoContent, MediaURL: "u", MediaMetadata: json.RawMessage(`{"duration":3}`)},
+		},
+		{
+			name:    "invalid media metadata",
+			in:      SendMessageInput{ContentType: domain.VideoContent, MediaURL: "u", MediaMetadata: json.RawMessage(`{"duration":`)},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSendInput(tt.in)
+			if tt.wantErr && !errors.Is(err, ErrInvalidArgument) {
+				t.Errorf("err = %v, want ErrInvalidArgument", err)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("err = %v, want nil", err)
+			}
+		})
+	}
+}
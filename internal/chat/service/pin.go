@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/google/uuid"
+)
+
+// pinDurations are the pin lifetimes a client may choose from.
+var pinDurations = map[string]time.Duration{
+	"24h": 24 * time.Hour,
+	"7d":  7 * 24 * time.Hour,
+	"30d": 30 * 24 * time.Hour,
+}
+
+// defaultPinDuration is used when the client does not choose one.
+const defaultPinDuration = "7d"
+
+// PinMessage pins a message to the top of its chat for the chosen duration ("24h", "7d"
+// or "30d"). In groups only admins may pin. Pinning an already pinned message restarts
+// its timer; once the chat is at its pin limit the oldest pin is dropped.
+func (s *ChatService) PinMessage(ctx context.Context, callerID string, messageID int64, duration string) (*domain.PinnedMessage, error) {
+	if duration == "" {
+		duration = defaultPinDuration
+	}
+	ttl, ok := pinDurations[duration]
+	if !ok {
+		return nil, fmt.Errorf("%w: pin duration must be 24h, 7d or 30d", ErrInvalidArgument)
+	}
+	msg, err := s.findPinnableMessage(ctx, callerID, messageID)
+	if err != nil {
+		return nil, err
+	}
+	if msg.DeletedAt != nil || msg.ContentType == domain.SystemNotificationContent || msg.ContentType == domain.DeletedContent {
+		return nil, fmt.Errorf("%w: this message cannot be pinned", ErrInvalidArgument)
+	}
+
+	caller, _ := uuid.Parse(callerID)
+	now := time.Now()
+	pin := &domain.PinnedMessage{
+		ChatID:         msg.ChatID,
+		MessageID:      msg.ID,
+		PinnedByUserID: caller,
+		PinnedAt:       now,
+		ExpiresAt:      now.Add(ttl),
+	}
+	if err := s.pinRepo.Pin(ctx, pin, s.cfg.MaxPinnedMessages); err != nil {
+		return nil, err
+	}
+	s.postSystemNotification(ctx, msg.ChatID, domain.SystemNotification{
+		Event:       domain.MessagePinnedEvent,
+		ActorUserID: &caller,
+		MessageID:   &msg.ID,
+	})
+	return pin, nil
+}
+
+// UnpinMessage removes a pin. In groups only admins may unpin.
+func (s *ChatService) UnpinMessage(ctx context.Context, callerID string, messageID int64) error {
+	msg, err := s.findPinnableMessage(ctx, callerID, messageID)
+	if err != nil {
+		return err
+	}
+	_, err = s.pinRepo.Unpin(ctx, msg.ChatID.String(), messageID)
+	return err
+}
+
+// findPinnableMessage loads a message and checks that the caller may change its chat's
+// pins: any active member of a one-to-one chat, or an active admin of a group.
+func (s *ChatService) findPinnableMessage(ctx context.Context, callerID string, messageID int64) (*domain.Message, error) {
+	msg, err := s.messageRepo.FindByID(ctx, messageID)
+	if err != nil {
+		return nil, err
+	}
+	if msg == nil {
+		return nil, ErrMessageNotFound
+	}
+	member, err := s.requireActiveMember(ctx, msg.ChatID.String(), callerID)
+	if err != nil {
+		return nil, err
+	}
+	chat, err := s.chatRepo.FindByID(ctx, msg.ChatID.String())
+	if err != nil {
+		return nil, err
+	}
+	if chat == nil {
+		return nil, ErrChatNotFound
+	}
+	if chat.Type == domain.GroupChat && member.Role != domain.AdminRole {
+		return nil, ErrNotGroupAdmin
+	}
+	return msg, nil
+}
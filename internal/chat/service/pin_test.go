@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dykethecreator/GoApp/internal/chat/repository"
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/google/uuid"
+)
+
+// storedMessages is a MessageRepository over fixed messages that records what is posted.
+type storedMessages struct {
+	postedMessages
+	msgs map[int64]*domain.Message
+}
+
+func (r *storedMessages) FindByID(ctx context.Context, messageID int64) (*domain.Message, error) {
+	return r.msgs[messageID], nil
+}
+
+type pinLog struct {
+	repository.PinRepository
+	pins []*domain.PinnedMessage
+}
+
+func (r *pinLog) Pin(ctx context.Context, pin *domain.PinnedMessage, maxPins int) error {
+	r.pins = append(r.pins, pin)
+	return nil
+}
+
+func TestPinMessage(t *testing.T) {
+	chatID, adminID, memberID := uuid.New(), uuid.New(), uuid.New()
+	members := map[uuid.UUID]*domain.ChatMember{
+		adminID:  {ChatID: chatID, UserID: adminID, Role: domain.AdminRole, MembershipStatus: domain.ActiveMembership},
+		memberID: {ChatID: chatID, UserID: memberID, Role: domain.MemberRole, MembershipStatus: domain.ActiveMembership},
+	}
+	now := time.Now()
+	msgs := map[int64]*domain.Message{
+		1: {ID: 1, ChatID: chatID, ContentType: domain.TextContent, Content: "hi"},
+		2: {ID: 2, ChatID: chatID, ContentType: domain.SystemNotificationContent},
+		3: {ID: 3, ChatID: chatID, ContentType: domain.TextContent, DeletedAt: &now},
+	}
+	tests := []struct {
+		name     string
+		chatType domain.ChatType
+		caller   uuid.UUID
+		message  int64
+		duration string
+		wantTTL  time.Duration
+		wantErr  error
+	}{
+		{name: "default duration", chatType: domain.GroupChat, caller: adminID, message: 1, wantTTL: 7 * 24 * time.Hour},
+		{name: "chosen duration", chatType: domain.GroupChat, caller: adminID, message: 1, duration: "24h", wantTTL: 24 * time.Hour},
+		{name: "any member in one-to-one chats", chatType: domain.OneToOneChat, caller: memberID, message: 1, duration: "30d", wantTTL: 30 * 24 * time.Hour},
+		{name: "group member who is not an admin", chatType: domain.GroupChat, caller: memberID, message: 1, wantErr: ErrNotGroupAdmin},
+		{name: "unknown duration", chatType: domain.GroupChat, caller: adminID, message: 1, duration: "1h", wantErr: ErrInvalidArgument},
+		{name: "system notification", chatType: domain.GroupChat, caller: adminID, message: 2, wantErr: ErrInvalidArgument},
+		{name: "deleted message", chatType: domain.GroupChat, caller: adminID, message: 3, wantErr: ErrInvalidArgument},
+		{name: "unknown message", chatType: domain.GroupChat, caller: adminID, message: 4, wantErr: ErrMessageNotFound},
+		{name: "non-member", chatType: domain.GroupChat, caller: uuid.New(), message: 1, wantErr: ErrNotChatMember},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chats := &memberChats{chat: &domain.Chat{ID: chatID, Type: tt.chatType}, members: members}
+			pins := &pinLog{}
+			s := NewChatService(Repositories{Chats: chats, Messages: &storedMessages{msgs: msgs}, Pins: pins}, DefaultConfig())
+			pin, err := s.PinMessage(context.Background(), tt.caller.String(), tt.message, tt.duration)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if len(pins.pins) != 0 {
+					t.Error("a rejected pin was stored")
+				}
+				return
+			}
+			if got := pin.ExpiresAt.Sub(pin.PinnedAt); got != tt.wantTTL {
+				t.Errorf("pin lasts %s, want %s", got, tt.wantTTL)
+			}
+		})
+	}
+}
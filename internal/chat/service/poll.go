@@ -0,0 +1,280 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/google/uuid"
+)
+
+const (
+	minPollOptions = 2
+	maxPollOptions = 12
+	// maxPollTextLen matches poll_options.option_text VARCHAR(255) and also bounds the question.
+	maxPollTextLen = 255
+)
+
+// CreatePollInput carries the fields a client sets when creating a poll.
+type CreatePollInput struct {
+	ChatID               string
+	Question             string
+	Options              []string
+	AllowMultipleAnswers bool
+}
+
+// PollResults is a poll with its current tally as seen by one reader.
+type PollResults struct {
+	Poll        *domain.Poll
+	Options     []*PollOptionResult
+	MyOptionIDs []int64 // options the reader voted for
+	TotalVoters int     // distinct users who voted
+}
+
+// PollOptionResult is one option of a poll and the votes it received, earliest first.
+type PollOptionResult struct {
+	Option *domain.PollOption
+	Voters []*domain.PollVote
+}
+
+// CreatePoll creates a poll in a chat and posts it as a PollContent message.
+func (s *ChatService) CreatePoll(ctx context.Context, callerID string, in CreatePollInput) (*domain.Message, *PollResults, error) {
+	if _, err := s.requireActiveMember(ctx, in.ChatID, callerID); err != nil {
+		return nil, nil, err
+	}
+	if err := s.ensureDirectChatUnblocked(ctx, in.ChatID, callerID); err != nil {
+		return nil, nil, err
+	}
+	question, options, err := parsePollInput(in)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	chatID, _ := uuid.Parse(in.ChatID)
+	caller, err := uuid.Parse(callerID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: invalid caller id", ErrInvalidArgument)
+	}
+	poll := &domain.Poll{
+		ID:                   uuid.New(),
+		ChatID:               chatID,
+		CreatedByUserID:      caller,
+		QuestionText:         question,
+		AllowMultipleAnswers: in.AllowMultipleAnswers,
+	}
+	payload, err := json.Marshal(domain.PollMessage{PollID: poll.ID, Question: poll.QuestionText})
+	if err != nil {
+		return nil, nil, err
+	}
+	msg, err := s.pollRepo.Create(ctx, poll, options, &domain.Message{
+		ChatID:      chatID,
+		SenderID:    &caller,
+		ContentType: domain.PollContent,
+		Content:     string(payload),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	results := &PollResults{Poll: poll}
+	for _, o := range options {
+		results.Options = append(results.Options, &PollOptionResult{Option: o})
+	}
+	return msg, results, nil
+}
+
+// parsePollInput checks a poll's question and options and returns them trimmed.
+func parsePollInput(in CreatePollInput) (string, []*domain.PollOption, error) {
+	question := strings.TrimSpace(in.Question)
+	if question == "" {
+		return "", nil, fmt.Errorf("%w: poll question is required", ErrInvalidArgument)
+	}
+	if utf8.RuneCountInString(question) > maxPollTextLen {
+		return "", nil, fmt.Errorf("%w: poll question longer than %d characters", ErrInvalidArgument, maxPollTextLen)
+	}
+	if len(in.Options) < minPollOptions || len(in.Options) > maxPollOptions {
+		return "", nil, fmt.Errorf("%w: a poll needs between %d and %d options", ErrInvalidArgument, minPollOptions, maxPollOptions)
+	}
+	seen := map[string]bool{}
+	options := make([]*domain.PollOption, 0, len(in.Options))
+	for _, text := range in.Options {
+		text = strings.TrimSpace(text)
+		if text == "" {
+			return "", nil, fmt.Errorf("%w: poll options cannot be empty", ErrInvalidArgument)
+		}
+		if utf8.RuneCountInString(text) > maxPollTextLen {
+			return "", nil, fmt.Errorf("%w: poll option longer than %d characters", ErrInvalidArgument, maxPollTextLen)
+		}
+		if seen[text] {
+			return "", nil, fmt.Errorf("%w: duplicate poll option %q", ErrInvalidArgument, text)
+		}
+		seen[text] = true
+		options = append(options, &domain.PollOption{OptionText: text})
+	}
+	return question, options, nil
+}
+
+// Vote sets the caller's choice in an open poll, replacing any earlier vote. Single-answer
+// polls take exactly one option; multi-select polls take one or more. Polls whose message
+// was deleted or has expired take no votes.
+func (s *ChatService) Vote(ctx context.Context, callerID string, pollID string, optionIDs []int64) (*PollResults, error) {
+	poll, err := s.findVotablePoll(ctx, callerID, pollID)
+	if err != nil {
+		return nil, err
+	}
+	if len(optionIDs) == 0 {
+		return nil, fmt.Errorf("%w: choose at least one option", ErrInvalidArgument)
+	}
+	if len(optionIDs) > 1 && !poll.AllowMultipleAnswers {
+		return nil, fmt.Errorf("%w: this poll allows a single answer", ErrInvalidArgument)
+	}
+	options, err := s.pollRepo.ListOptions(ctx, pollID)
+	if err != nil {
+		return nil, err
+	}
+	valid := map[int64]bool{}
+	for _, o := range options {
+		valid[o.ID] = true
+	}
+	chosen := map[int64]bool{}
+	var ids []int64
+	for _, id := range optionIDs {
+		if !valid[id] {
+			return nil, fmt.Errorf("%w: option %d does not belong to this poll", ErrInvalidArgument, id)
+		}
+		if !chosen[id] {
+			chosen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	if err := s.pollRepo.ReplaceVotes(ctx, pollID, callerID, ids, time.Now()); err != nil {
+		return nil, err
+	}
+	return s.pollResults(ctx, callerID, poll)
+}
+
+// RetractVote removes the caller's vote from an open poll.
+func (s *ChatService) RetractVote(ctx context.Context, callerID string, pollID string) (*PollResults, error) {
+	poll, err := s.findVotablePoll(ctx, callerID, pollID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.pollRepo.DeleteVotes(ctx, pollID, callerID); err != nil {
+		return nil, err
+	}
+	return s.pollResults(ctx, callerID, poll)
+}
+
+// GetPollResults returns the tally of a poll with the voters of each option.
+func (s *ChatService) GetPollResults(ctx context.Context, callerID string, pollID string) (*PollResults, error) {
+	poll, err := s.findPoll(ctx, callerID, pollID)
+	if err != nil {
+		return nil, err
+	}
+	return s.pollResults(ctx, callerID, poll)
+}
+
+// ClosePoll stops voting on a poll. Only its creator or, in groups, an admin may close it.
+func (s *ChatService) ClosePoll(ctx context.Context, callerID string, pollID string) (*PollResults, error) {
+	poll, err := s.findPoll(ctx, callerID, pollID)
+	if err != nil {
+		return nil, err
+	}
+	if poll.CreatedByUserID.String() != callerID {
+		_, err := s.requireGroupAdmin(ctx, poll.ChatID.String(), callerID)
+		if errors.Is(err, ErrNotGroupAdmin) || errors.Is(err, ErrNotGroupChat) {
+			return nil, ErrNotPollCreator
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	now := time.Now()
+	closed, err := s.pollRepo.Close(ctx, pollID, callerID, now)
+	if err != nil {
+		return nil, err
+	}
+	if closed {
+		poll.ClosedAt = &now
+	}
+	return s.pollResults(ctx, callerID, poll)
+}
+
+// findPoll loads a poll of a chat the caller is an active member of.
+func (s *ChatService) findPoll(ctx context.Context, callerID string, pollID string) (*domain.Poll, error) {
+	if _, err := uuid.Parse(pollID); err != nil {
+		return nil, fmt.Errorf("%w: invalid poll id", ErrInvalidArgument)
+	}
+	poll, err := s.pollRepo.FindByID(ctx, pollID)
+	if err != nil {
+		return nil, err
+	}
+	if poll == nil {
+		return nil, ErrPollNotFound
+	}
+	if _, err := s.requireActiveMember(ctx, poll.ChatID.String(), callerID); err != nil {
+		return nil, err
+	}
+	return poll, nil
+}
+
+// findVotablePoll loads a poll the caller may vote in: open, and still posted in the chat.
+func (s *ChatService) findVotablePoll(ctx context.Context, callerID string, pollID string) (*domain.Poll, error) {
+	poll, err := s.findPoll(ctx, callerID, pollID)
+	if err != nil {
+		return nil, err
+	}
+	if poll.MessageID == nil {
+		return nil, ErrPollNotFound
+	}
+	msg, err := s.messageRepo.FindByID(ctx, *poll.MessageID)
+	if err != nil {
+		return nil, err
+	}
+	if !pollMessageLive(msg, time.Now()) {
+		return nil, ErrPollNotFound
+	}
+	if poll.ClosedAt != nil {
+		return nil, ErrPollClosed
+	}
+	return poll, nil
+}
+
+// pollMessageLive reports whether a poll's message is still in the chat at now.
+func pollMessageLive(msg *domain.Message, now time.Time) bool {
+	return msg != nil && msg.DeletedAt == nil && (msg.ExpiresAt == nil || msg.ExpiresAt.After(now))
+}
+
+func (s *ChatService) pollResults(ctx context.Context, readerID string, poll *domain.Poll) (*PollResults, error) {
+	options, err := s.pollRepo.ListOptions(ctx, poll.ID.String())
+	if err != nil {
+		return nil, err
+	}
+	votes, err := s.pollRepo.ListVotes(ctx, poll.ID.String())
+	if err != nil {
+		return nil, err
+	}
+	results := &PollResults{Poll: poll}
+	byOption := map[int64]*PollOptionResult{}
+	for _, o := range options {
+		r := &PollOptionResult{Option: o}
+		byOption[o.ID] = r
+		results.Options = append(results.Options, r)
+	}
+	voters := map[uuid.UUID]bool{}
+	for _, v := range votes {
+		if r, ok := byOption[v.OptionID]; ok {
+			r.Voters = append(r.Voters, v)
+		}
+		voters[v.UserID] = true
+		if v.UserID.String() == readerID {
+			results.MyOptionIDs = append(results.MyOptionIDs, v.OptionID)
+		}
+	}
+	results.TotalVoters = len(voters)
+	return results, nil
+}
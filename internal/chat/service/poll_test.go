@@ -0,0 +1,76 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dykethecreator/GoApp/pkg/domain"
+)
+
+func TestParsePollInput(t *testing.T) {
+	long := strings.Repeat("ş", maxPollTextLen+1)
+	tests := []struct {
+		name        string
+		in          CreatePollInput
+		wantOptions []string
+		wantErr     bool
+	}{
+		{name: "trimmed", in: CreatePollInput{Question: " Lunch? ", Options: []string{" pizza", "soup "}}, wantOptions: []string{"pizza", "soup"}},
+		{name: "max length counts runes", in: CreatePollInput{Question: long[:len(long)-len("ş")], Options: []string{"a", "b"}}, wantOptions: []string{"a", "b"}},
+		{name: "blank question", in: CreatePollInput{Question: "  ", Options: []string{"a", "b"}}, wantErr: true},
+		{name: "long question", in: CreatePollInput{Question: long, Options: []string{"a", "b"}}, wantErr: true},
+		{name: "one option", in: CreatePollInput{Question: "q", Options: []string{"a"}}, wantErr: true},
+		{name: "too many options", in: CreatePollInput{Question: "q", Options: strings.Split("abcdefghijklm", "")}, wantErr: true},
+		{name: "blank option", in: CreatePollInput{Question: "q", Options: []string{"a", " "}}, wantErr: true},
+		{name: "long option", in: CreatePollInput{Question: "q", Options: []string{"a", long}}, wantErr: true},
+		{name: "duplicate after trimming", in: CreatePollInput{Question: "q", Options: []string{"a", " a"}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			question, options, err := parsePollInput(tt.in)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidArgument) {
+					t.Fatalf("err = %v, want ErrInvalidArgument", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parsePollInput: %v", err)
+			}
+			if question != strings.TrimSpace(tt.in.Question) {
+				t.Errorf("question = %q", question)
+			}
+			var got []string
+			for _, o := range options {
+				got = append(got, o.OptionText)
+			}
+			if strings.Join(got, "|") != strings.Join(tt.wantOptions, "|") {
+				t.Errorf("options = %q, want %q", got, tt.wantOptions)
+			}
+		})
+	}
+}
+
+func TestPollMessageLive(t *testing.T) {
+	now := time.Now()
+	past, future := now.Add(-time.Second), now.Add(time.Hour)
+	tests := []struct {
+		name string
+		msg  *domain.Message
+		want bool
+	}{
+		{name: "posted", msg: &domain.Message{}, want: true},
+		{name: "expires later", msg: &domain.Message{ExpiresAt: &future}, want: true},
+		{name: "gone", msg: nil, want: false},
+		{name: "deleted", msg: &domain.Message{DeletedAt: &past}, want: false},
+		{name: "expired", msg: &domain.Message{ExpiresAt: &past}, want: false},
+		{name: "expires now", msg: &domain.Message{ExpiresAt: &now}, want: false},
+	}
+	for _, tt := range tests {
+		if got := pollMessageLive(tt.msg, now); got != tt.want {
+			t.Errorf("%s: pollMessageLive = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
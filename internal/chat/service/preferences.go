@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dykethecreator/GoApp/pkg/domain"
+)
+
+// muteDurations are the mute periods a member may choose; 0 mutes until unmuted.
+var muteDurations = map[string]time.Duration{
+	"8h":     8 * time.Hour,
+	"1w":     7 * 24 * time.Hour,
+	"always": 0,
+}
+
+// SetChatMuted mutes the chat for the caller for "8h", "1w" or "always", or unmutes it.
+// A timed mute ends by itself once it expires.
+func (s *ChatService) SetChatMuted(ctx context.Context, callerID string, chatID string, muted bool, duration string) (*domain.ChatMember, error) {
+	if _, err := s.requireActiveMember(ctx, chatID, callerID); err != nil {
+		return nil, err
+	}
+	var until *time.Time
+	if muted {
+		d, ok := muteDurations[duration]
+		if !ok {
+			return nil, fmt.Errorf("%w: mute duration must be 8h, 1w or always", ErrInvalidArgument)
+		}
+		if d > 0 {
+			t := time.Now().Add(d)
+			until = &t
+		}
+	}
+	if err := s.chatRepo.UpdateMute(ctx, chatID, callerID, muted, until); err != nil {
+		return nil, err
+	}
+	return s.chatRepo.FindMember(ctx, chatID, callerID)
+}
+
+// SetChatArchived moves the chat into or out of the caller's archive. Archiving unpins the
+// chat; with unarchiveOnMessage the next message from someone else brings it back to the inbox.
+func (s *ChatService) SetChatArchived(ctx context.Context, callerID string, chatID string, archived bool, unarchiveOnMessage bool) (*domain.ChatMember, error) {
+	if _, err := s.requireActiveMember(ctx, chatID, callerID); err != nil {
+		return nil, err
+	}
+	if err := s.chatRepo.UpdateArchived(ctx, chatID, callerID, archived, unarchiveOnMessage); err != nil {
+		return nil, err
+	}
+	return s.chatRepo.FindMember(ctx, chatID, callerID)
+}
+
+// SetChatUnread marks the chat as unread for the caller, or clears the mark. Reading the
+// chat with MarkRead clears it too.
+func (s *ChatService) SetChatUnread(ctx context.Context, callerID string, chatID string, unread bool) (*domain.ChatMember, error) {
+	if _, err := s.requireActiveMember(ctx, chatID, callerID); err != nil {
+		return nil, err
+	}
+	if err := s.chatRepo.UpdateMarkedUnread(ctx, chatID, callerID, unread); err != nil {
+		return nil, err
+	}
+	return s.chatRepo.FindMember(ctx, chatID, callerID)
+}
+
+// SetChatPinned pins the chat to the top of the caller's chat list, or unpins it. At most
+// MaxPinnedChats chats can be pinned; pinning an archived chat unarchives it.
+func (s *ChatService) SetChatPinned(ctx context.Context, callerID string, chatID string, pinned bool) (*domain.ChatMember, error) {
+	member, err := s.requireActiveMember(ctx, chatID, callerID)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case !pinned:
+		if err := s.chatRepo.UnpinChat(ctx, chatID, callerID); err != nil {
+			return nil, err
+		}
+	case member.PinnedAt == nil:
+		ok, err := s.chatRepo.PinChat(ctx, chatID, callerID, time.Now(), s.cfg.MaxPinnedChats)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, ErrChatPinLimit
+		}
+	}
+	return s.chatRepo.FindMember(ctx, chatID, callerID)
+}
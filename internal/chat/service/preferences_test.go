@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/google/uuid"
+)
+
+// muteLog is a ChatRepository that records the caller's mute setting.
+type muteLog struct {
+	memberChats
+	muted bool
+	until *time.Time
+	calls int
+}
+
+func (r *muteLog) UpdateMute(ctx context.Context, chatID string, userID string, muted bool, until *time.Time) error {
+	r.muted, r.until = muted, until
+	r.calls++
+	return nil
+}
+
+func TestSetChatMuted(t *testing.T) {
+	chatID, userID := uuid.New(), uuid.New()
+	tests := []struct {
+		name      string
+		muted     bool
+		duration  string
+		wantUntil time.Duration // 0 for no end
+		wantErr   bool
+	}{
+		{name: "eight hours", muted: true, duration: "8h", wantUntil: 8 * time.Hour},
+		{name: "one week", muted: true, duration: "1w", wantUntil: 7 * 24 * time.Hour},
+		{name: "always", muted: true, duration: "always"},
+		{name: "unmute ignores the duration", muted: false, duration: "bogus"},
+		{name: "unknown duration", muted: true, duration: "1h", wantErr: true},
+		{name: "no duration", muted: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chats := &muteLog{memberChats: memberChats{members: map[uuid.UUID]*domain.ChatMember{
+				userID: {ChatID: chatID, UserID: userID, MembershipStatus: domain.ActiveMembership},
+			}}}
+			s := NewChatService(Repositories{Chats: chats}, DefaultConfig())
+			start := time.Now()
+			_, err := s.SetChatMuted(context.Background(), userID.String(), chatID.String(), tt.muted, tt.duration)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidArgument) {
+					t.Fatalf("err = %v, want ErrInvalidArgument", err)
+				}
+				if chats.calls != 0 {
+					t.Error("an invalid mute was stored")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("SetChatMuted: %v", err)
+			}
+			if chats.muted != tt.muted {
+				t.Errorf("muted = %v, want %v", chats.muted, tt.muted)
+			}
+			switch {
+			case tt.wantUntil == 0 && chats.until != nil:
+				t.Errorf("muted until %s, want no end", chats.until)
+			case tt.wantUntil > 0 && (chats.until == nil || chats.until.Sub(start) < tt.wantUntil || chats.until.Sub(start) > tt.wantUntil+time.Minute):
+				t.Errorf("muted until %v, want %s from now", chats.until, tt.wantUntil)
+			}
+		})
+	}
+}
+
+func TestSetChatMutedRequiresMembership(t *testing.T) {
+	chats := &muteLog{memberChats: memberChats{members: map[uuid.UUID]*domain.ChatMember{}}}
+	s := NewChatService(Repositories{Chats: chats}, DefaultConfig())
+	if _, err := s.SetChatMuted(context.Background(), uuid.NewString(), uuid.NewString(), true, "8h"); !errors.Is(err, ErrNotChatMember) {
+		t.Fatalf("err = %v, want ErrNotChatMember", err)
+	}
+}
@@ -0,0 +1,41 @@
+package service
+
+import (
+	"context"
+
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/google/uuid"
+)
+
+// privacyAllows reports whether ownerID's privacy settings let viewerID see, or for
+// GroupAddSetting do, what setting covers.
+func (s *ChatService) privacyAllows(ctx context.Context, ownerID string, viewerID string, setting domain.PrivacySetting) (bool, error) {
+	viewer, err := uuid.Parse(viewerID)
+	if err != nil {
+		return false, err
+	}
+	settings, err := s.privacyRepo.Find(ctx, ownerID)
+	if err != nil {
+		return false, err
+	}
+	isContact := false
+	if settings.NeedsContact(setting) {
+		if isContact, err = s.privacyRepo.IsContact(ctx, ownerID, viewerID); err != nil {
+			return false, err
+		}
+	}
+	return settings.Allows(setting, viewer, isContact), nil
+}
+
+// canAddToGroup reports whether adderID may put userID into a group: neither may have
+// blocked the other and userID's group-add setting must let adderID through.
+func (s *ChatService) canAddToGroup(ctx context.Context, adderID string, userID string) (bool, error) {
+	blocked, err := s.blockRepo.IsBlockedBetween(ctx, adderID, userID)
+	if err != nil {
+		return false, err
+	}
+	if blocked {
+		return false, nil
+	}
+	return s.privacyAllows(ctx, userID, adderID, domain.GroupAddSetting)
+}
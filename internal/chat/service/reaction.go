@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/google/uuid"
+)
+
+// maxEmojiRunes matches message_reactions.reaction_emoji VARCHAR(10), which Postgres
+// measures in characters (code points).
+const maxEmojiRunes = 10
+
+// SetReaction sets the caller's reaction on a message, replacing any previous one.
+func (s *ChatService) SetReaction(ctx context.Context, callerID string, messageID int64, emoji string) error {
+	if err := validateEmoji(emoji); err != nil {
+		return err
+	}
+	msg, err := s.findReactableMessage(ctx, callerID, messageID)
+	if err != nil {
+		return err
+	}
+	user, _ := uuid.Parse(callerID)
+	return s.reactionRepo.Upsert(ctx, &domain.MessageReaction{
+		MessageID:     msg.ID,
+		UserID:        user,
+		ReactionEmoji: emoji,
+		CreatedAt:     time.Now(),
+	})
+}
+
+// RemoveReaction removes the caller's reaction from a message, if any.
+func (s *ChatService) RemoveReaction(ctx context.Context, callerID string, messageID int64) error {
+	msg, err := s.messageRepo.FindByID(ctx, messageID)
+	if err != nil {
+		return err
+	}
+	if msg == nil {
+		return ErrMessageNotFound
+	}
+	if _, err := s.requireActiveMember(ctx, msg.ChatID.String(), callerID); err != nil {
+		return err
+	}
+	_, err = s.reactionRepo.Delete(ctx, messageID, callerID)
+	return err
+}
+
+// findReactableMessage loads a live, user-authored message in a chat the caller is active in.
+func (s *ChatService) findReactableMessage(ctx context.Context, callerID string, messageID int64) (*domain.Message, error) {
+	msg, err := s.messageRepo.FindByID(ctx, messageID)
+	if err != nil {
+		return nil, err
+	}
+	if msg == nil {
+		return nil, ErrMessageNotFound
+	}
+	if _, err := s.requireActiveMember(ctx, msg.ChatID.String(), callerID); err != nil {
+		return nil, err
+	}
+	if msg.DeletedAt != nil || msg.ContentType == domain.SystemNotificationContent || msg.ContentType == domain.DeletedContent {
+		return nil, fmt.Errorf("%w: this message cannot be reacted to", ErrInvalidArgument)
+	}
+	return msg, nil
+}
+
+// validateEmoji accepts a single emoji sequence: symbols plus the joiners, variation
+// selectors and modifiers used to compose them, within the column's 10 characters.
+func validateEmoji(emoji string) error {
+	if emoji == "" || !utf8.ValidString(emoji) {
+		return fmt.Errorf("%w: reaction emoji is required", ErrInvalidArgument)
+	}
+	if utf8.RuneCountInString(emoji) > maxEmojiRunes {
+		return fmt.Errorf("%w: reaction emoji longer than %d characters", ErrInvalidArgument, maxEmojiRunes)
+	}
+	keycap := strings.ContainsRune(emoji, '\u20e3')
+	hasSymbol := false
+	for _, r := range emoji {
+		switch {
+		case r == '\u200d', r == '\ufe0f', r == '\ufe0e', r == '\u20e3':
+			// zero-width joiner, variation selectors, combining keycap
+		case r >= 0xE0020 && r <= 0xE007F:
+			// tag characters used by subdivision flags
+		case keycap && (r == '#' || r == '*' || (r >= '0' && r <= '9')):
+			// base of a keycap sequence such as 1️⃣
+		case r >= utf8.RuneSelf && unicode.In(r, unicode.So, unicode.Sk):
+			hasSymbol = true
+		default:
+			return fmt.Errorf("%w: reaction must be an emoji", ErrInvalidArgument)
+		}
+	}
+	if !hasSymbol && !keycap {
+		return fmt.Errorf("%w: reaction must be an emoji", ErrInvalidArgument)
+	}
+	return nil
+}
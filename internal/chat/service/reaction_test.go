@@ -0,0 +1,42 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidateEmoji(t *testing.T) {
+	tests := []struct {
+		name  string
+		emoji string
+		ok    bool
+	}{
+		{"single emoji", "👍", true},
+		{"skin tone modifier", "👍🏽", true},
+		{"zero-width joiner sequence", "👨\u200d👩\u200d👧", true},
+		{"variation selector", "❤️", true},
+		{"keycap", "1️⃣", true},
+		{"flag", "🇹🇷", true},
+		{"subdivision flag", "🏴\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F", true},
+		{"empty", "", false},
+		{"letter", "a", false},
+		{"digit without keycap", "1", false},
+		{"emoji with text", "👍ok", false},
+		{"emoji with space", "👍 ", false},
+		{"joiners only", "\u200d\ufe0f", false},
+		{"invalid utf-8", "\xff", false},
+		{"longer than the column", strings.Repeat("👍", maxEmojiRunes+1), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateEmoji(tt.emoji)
+			if tt.ok && err != nil {
+				t.Errorf("validateEmoji(%q) = %v, want nil", tt.emoji, err)
+			}
+			if !tt.ok && !errors.Is(err, ErrInvalidArgument) {
+				t.Errorf("validateEmoji(%q) = %v, want ErrInvalidArgument", tt.emoji, err)
+			}
+		})
+	}
+}
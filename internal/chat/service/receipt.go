@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dykethecreator/GoApp/pkg/domain"
+)
+
+// maxReceiptBatch caps how many message IDs a single MarkDelivered call may carry.
+const maxReceiptBatch = 500
+
+// MarkDelivered records that the caller's device received the given messages and notifies
+// their senders. Messages the caller cannot see or already acknowledged are ignored.
+func (s *ChatService) MarkDelivered(ctx context.Context, callerID string, messageIDs []int64) (int, error) {
+	if len(messageIDs) == 0 {
+		return 0, nil
+	}
+	if len(messageIDs) > maxReceiptBatch {
+		return 0, fmt.Errorf("%w: at most %d message ids per call", ErrInvalidArgument, maxReceiptBatch)
+	}
+	updates, err := s.receiptRepo.MarkDelivered(ctx, callerID, messageIDs, time.Now())
+	if err != nil {
+		return 0, err
+	}
+	return len(updates), nil
+}
+
+// MarkRead records that the caller read every message in the chat up to and including
+// upToMessageID, lowers their unread counter and notifies the senders.
+func (s *ChatService) MarkRead(ctx context.Context, callerID string, chatID string, upToMessageID int64) (int, error) {
+	if _, err := s.requireActiveMember(ctx, chatID, callerID); err != nil {
+		return 0, err
+	}
+	if upToMessageID <= 0 {
+		return 0, fmt.Errorf("%w: up_to_message_id is required", ErrInvalidArgument)
+	}
+	updates, err := s.receiptRepo.MarkReadUpTo(ctx, callerID, chatID, upToMessageID, time.Now())
+	if err != nil {
+		return 0, err
+	}
+	return len(updates), nil
+}
+
+// GetMessageInfo returns per-member delivery and read timestamps for one of the caller's
+// own messages. Only the sender may see them.
+func (s *ChatService) GetMessageInfo(ctx context.Context, callerID string, messageID int64) (*domain.Message, []*domain.MessageStatus, error) {
+	msg, err := s.messageRepo.FindByID(ctx, messageID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if msg == nil {
+		return nil, nil, ErrMessageNotFound
+	}
+	if _, err := s.requireActiveMember(ctx, msg.ChatID.String(), callerID); err != nil {
+		return nil, nil, err
+	}
+	if msg.SenderID == nil || msg.SenderID.String() != callerID {
+		return nil, nil, ErrNotMessageSender
+	}
+	statuses, err := s.receiptRepo.ListForMessage(ctx, messageID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return msg, statuses, nil
+}
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/dykethecreator/GoApp/internal/chat/repository"
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/google/uuid"
+)
+
+// maxSearchQueryLen bounds the length of a search query in characters.
+const maxSearchQueryLen = 200
+
+// SearchInput holds the caller-supplied search parameters; zero-valued filters are ignored.
+type SearchInput struct {
+	Query        string
+	ChatID       string
+	SenderID     string
+	ContentTypes []domain.ContentType
+	From         *time.Time
+	To           *time.Time
+	Cursor       string
+	Limit        int
+}
+
+// SearchMessages runs a full-text search over the chats the caller is an active member of,
+// newest first. nextCursor is empty when there are no more results.
+func (s *ChatService) SearchMessages(ctx context.Context, callerID string, in SearchInput) ([]*repository.SearchHit, string, error) {
+	query := strings.TrimSpace(in.Query)
+	if query == "" {
+		return nil, "", fmt.Errorf("%w: search query is required", ErrInvalidArgument)
+	}
+	if utf8.RuneCountInString(query) > maxSearchQueryLen {
+		return nil, "", fmt.Errorf("%w: search query longer than %d characters", ErrInvalidArgument, maxSearchQueryLen)
+	}
+	if in.ChatID != "" {
+		if _, err := s.requireActiveMember(ctx, in.ChatID, callerID); err != nil {
+			return nil, "", err
+		}
+	}
+	if in.SenderID != "" {
+		if _, err := uuid.Parse(in.SenderID); err != nil {
+			return nil, "", fmt.Errorf("%w: invalid sender id", ErrInvalidArgument)
+		}
+	}
+	if in.From != nil && in.To != nil && !in.From.Before(*in.To) {
+		return nil, "", fmt.Errorf("%w: from must be before to", ErrInvalidArgument)
+	}
+	before, err := decodeCursor(in.Cursor)
+	if err != nil {
+		return nil, "", err
+	}
+	limit := in.Limit
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	hits, err := s.messageRepo.Search(ctx, callerID, repository.MessageSearch{
+		Query:        query,
+		ChatID:       in.ChatID,
+		SenderID:     in.SenderID,
+		ContentTypes: in.ContentTypes,
+		From:         in.From,
+		To:           in.To,
+		Before:       before,
+		Limit:        limit + 1,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	next := ""
+	if len(hits) > limit {
+		hits = hits[:limit]
+		last := hits[len(hits)-1].Message
+		next = encodeCursor(repository.MessageCursor{CreatedAt: last.CreatedAt, ID: last.ID})
+	}
+	return hits, next, nil
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dykethecreator/GoApp/internal/chat/repository"
+	"github.com/dykethecreator/GoApp/pkg/domain"
+)
+
+// searchIndex is a MessageRepository that returns up to the requested number of hits,
+// newest first, from a fixed list.
+type searchIndex struct {
+	repository.MessageRepository
+	hits   []*repository.SearchHit
+	params []repository.MessageSearch
+}
+
+func (r *searchIndex) Search(ctx context.Context, userID string, params repository.MessageSearch) ([]*repository.SearchHit, error) {
+	r.params = append(r.params, params)
+	hits := r.hits
+	if params.Before != nil {
+		for i, h := range hits {
+			if h.Message.ID < params.Before.ID {
+				hits = hits[i:]
+				break
+			}
+		}
+	}
+	if len(hits) > params.Limit {
+		hits = hits[:params.Limit]
+	}
+	return hits, nil
+}
+
+func TestSearchMessagesValidation(t *testing.T) {
+	from := time.Now()
+	to := from.Add(-time.Hour)
+	tests := []struct {
+		name string
+		in   SearchInput
+	}{
+		{"blank query", SearchInput{Query: "  "}},
+		{"long query", SearchInput{Query: strings.Repeat("ş", maxSearchQueryLen+1)}},
+		{"invalid chat id", SearchInput{Query: "hi", ChatID: "chat-1"}},
+		{"invalid sender id", SearchInput{Query: "hi", SenderID: "bob"}},
+		{"from after to", SearchInput{Query: "hi", From: &from, To: &to}},
+		{"malformed cursor", SearchInput{Query: "hi", Cursor: "!"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			index := &searchIndex{}
+			s := NewChatService(Repositories{Messages: index}, DefaultConfig())
+			if _, _, err := s.SearchMessages(context.Background(), "caller", tt.in); !errors.Is(err, ErrInvalidArgument) {
+				t.Fatalf("err = %v, want ErrInvalidArgument", err)
+			}
+			if len(index.params) != 0 {
+				t.Error("an invalid search reached the index")
+			}
+		})
+	}
+}
+
+func TestSearchMessagesPages(t *testing.T) {
+	now := time.Now()
+	index := &searchIndex{}
+	for id := int64(5); id >= 1; id-- {
+		index.hits = append(index.hits, &repository.SearchHit{Message: &domain.Message{ID: id, CreatedAt: now.Add(time.Duration(id) * time.Second)}})
+	}
+	s := NewChatService(Repositories{Messages: index}, DefaultConfig())
+
+	var got []int64
+	cursor := ""
+	for page := 0; page < 5; page++ {
+		hits, next, err := s.SearchMessages(context.Background(), "caller", SearchInput{Query: " hello ", Cursor: cursor, Limit: 2})
+		if err != nil {
+			t.Fatalf("SearchMessages: %v", err)
+		}
+		for _, h := range hits {
+			got = append(got, h.Message.ID)
+		}
+		if next == "" {
+			break
+		}
+		cursor = next
+	}
+	if want := []int64{5, 4, 3, 2, 1}; !slices.Equal(got, want) {
+		t.Fatalf("paged through %v, want %v", got, want)
+	}
+	if q := index.params[0].Query; q != "hello" {
+		t.Errorf("query = %q, want it trimmed", q)
+	}
+
+	if _, _, err := s.SearchMessages(context.Background(), "caller", SearchInput{Query: "hello", Limit: 10 * maxPageSize}); err != nil {
+		t.Fatalf("SearchMessages: %v", err)
+	}
+	if got := index.params[len(index.params)-1].Limit; got != maxPageSize+1 {
+		t.Errorf("index asked for %d hits, want the page cap plus one", got)
+	}
+}
@@ -0,0 +1,209 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dykethecreator/GoApp/internal/chat/repository"
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/google/uuid"
+)
+
+// Config holds the tunable limits of the chat service.
+type Config struct {
+	// EditWindow is how long after sending a message its sender may still edit it.
+	EditWindow time.Duration
+	// InviteLinkBaseURL is prefixed to invite codes to build shareable links.
+	InviteLinkBaseURL string
+	// MaxPinnedMessages is how many messages a chat may have pinned at once; pinning
+	// another one unpins the oldest.
+	MaxPinnedMessages int
+	// MaxForwardChats is how many chats one ForwardMessages call may reach.
+	MaxForwardChats int
+	// FrequentlyForwardedThreshold is the forward count from which a message counts as
+	// frequently forwarded; such messages may reach only FrequentlyForwardedMaxChats chats at once.
+	FrequentlyForwardedThreshold int
+	FrequentlyForwardedMaxChats  int
+	// MaxPinnedChats is how many chats a user may pin to the top of their chat list.
+	MaxPinnedChats int
+}
+
+// DefaultConfig returns the limits used when nothing is configured.
+func DefaultConfig() Config {
+	return Config{
+		EditWindow:                   15 * time.Minute,
+		MaxPinnedMessages:            3,
+		MaxForwardChats:              5,
+		FrequentlyForwardedThreshold: 5,
+		FrequentlyForwardedMaxChats:  1,
+		MaxPinnedChats:               3,
+	}
+}
+
+// Repositories groups the data-layer dependencies of ChatService.
+type Repositories struct {
+	Chats     repository.ChatRepository
+	Messages  repository.MessageRepository
+	Invites   repository.InviteRepository
+	Blocks    repository.BlockRepository
+	Receipts  repository.ReceiptRepository
+	Reactions repository.ReactionRepository
+	Pins      repository.PinRepository
+	Polls     repository.PollRepository
+	Calls     repository.CallRepository
+	Privacy   repository.PrivacyRepository
+}
+
+type ChatService struct {
+	chatRepo     repository.ChatRepository
+	messageRepo  repository.MessageRepository
+	inviteRepo   repository.InviteRepository
+	blockRepo    repository.BlockRepository
+	receiptRepo  repository.ReceiptRepository
+	reactionRepo repository.ReactionRepository
+	pinRepo      repository.PinRepository
+	pollRepo     repository.PollRepository
+	callRepo     repository.CallRepository
+	privacyRepo  repository.PrivacyRepository
+	cfg          Config
+}
+
+// NewChatService creates a ChatService. Its repositories record domain events in the
+// transactional outbox; the message worker publishes them.
+func NewChatService(repos Repositories, cfg Config) *ChatService {
+	return &ChatService{
+		chatRepo:     repos.Chats,
+		messageRepo:  repos.Messages,
+		inviteRepo:   repos.Invites,
+		blockRepo:    repos.Blocks,
+		receiptRepo:  repos.Receipts,
+		reactionRepo: repos.Reactions,
+		pinRepo:      repos.Pins,
+		pollRepo:     repos.Polls,
+		callRepo:     repos.Calls,
+		privacyRepo:  repos.Privacy,
+		cfg:          cfg,
+	}
+}
+
+// CreateChat creates a group or one-to-one chat with the caller as a member.
+// For one-to-one chats an existing chat between the two users is returned instead of a duplicate.
+// Group members whose group-add privacy setting or a block keeps the caller from adding them
+// are left out; their IDs are returned.
+func (s *ChatService) CreateChat(ctx context.Context, callerID string, name string, memberIDs []string, isGroup bool) (*domain.Chat, []uuid.UUID, error) {
+	caller, err := uuid.Parse(callerID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: invalid caller id", ErrInvalidArgument)
+	}
+
+	// Deduplicate members and drop the caller, who is always added.
+	seen := map[uuid.UUID]bool{caller: true}
+	var others []uuid.UUID
+	for _, id := range memberIDs {
+		uid, err := uuid.Parse(id)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: invalid member id %q", ErrInvalidArgument, id)
+		}
+		if !seen[uid] {
+			seen[uid] = true
+			others = append(others, uid)
+		}
+	}
+
+	chat := &domain.Chat{CreatedByUserID: caller}
+	var restricted []uuid.UUID
+	members := []domain.ChatMember{{UserID: caller, Role: domain.MemberRole, MembershipStatus: domain.ActiveMembership}}
+
+	if isGroup {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			return nil, nil, fmt.Errorf("%w: group name is required", ErrInvalidArgument)
+		}
+		chat.Type = domain.GroupChat
+		chat.GroupName = &name
+		// The creator administers the group.
+		members[0].Role = domain.AdminRole
+
+		// Users whose privacy settings refuse the creator are left out and reported back.
+		allowed := others[:0]
+		for _, uid := range others {
+			ok, err := s.canAddToGroup(ctx, callerID, uid.String())
+			if err != nil {
+				return nil, nil, err
+			}
+			if ok {
+				allowed = append(allowed, uid)
+			} else {
+				restricted = append(restricted, uid)
+			}
+		}
+		others = allowed
+	} else {
+		if len(others) != 1 {
+			return nil, nil, fmt.Errorf("%w: a one-to-one chat needs exactly one other member", ErrInvalidArgument)
+		}
+		existing, err := s.chatRepo.FindOneToOne(ctx, callerID, others[0].String())
+		if err != nil {
+			return nil, nil, err
+		}
+		if existing != nil {
+			return existing, nil, nil
+		}
+		chat.Type = domain.OneToOneChat
+	}
+
+	for _, uid := range others {
+		members = append(members, domain.ChatMember{UserID: uid, Role: domain.MemberRole, MembershipStatus: domain.ActiveMembership})
+	}
+	created, err := s.chatRepo.CreateChat(ctx, chat, members)
+	if err != nil {
+		return nil, nil, err
+	}
+	return created, restricted, nil
+}
+
+// GetChats returns the caller's inbox, archive or whole chat list, pinned chats first and
+// each chat with its active pinned messages. An empty filter means the inbox.
+func (s *ChatService) GetChats(ctx context.Context, callerID string, filter repository.ChatListFilter) ([]*repository.ChatSummary, error) {
+	switch filter {
+	case "":
+		filter = repository.InboxChats
+	case repository.InboxChats, repository.ArchivedChats, repository.AllChats:
+	default:
+		return nil, fmt.Errorf("%w: filter must be inbox, archived or all", ErrInvalidArgument)
+	}
+	summaries, err := s.chatRepo.ListForUser(ctx, callerID, filter)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(summaries))
+	for _, cs := range summaries {
+		ids = append(ids, cs.Chat.ID.String())
+	}
+	pins, err := s.pinRepo.ListActive(ctx, ids, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	for _, cs := range summaries {
+		cs.Pins = pins[cs.Chat.ID.String()]
+	}
+	return summaries, nil
+}
+
+// requireActiveMember returns the caller's membership, or ErrNotChatMember when the
+// caller is not (or no longer) an active member of the chat.
+func (s *ChatService) requireActiveMember(ctx context.Context, chatID string, userID string) (*domain.ChatMember, error) {
+	if _, err := uuid.Parse(chatID); err != nil {
+		return nil, fmt.Errorf("%w: invalid chat id", ErrInvalidArgument)
+	}
+	member, err := s.chatRepo.FindMember(ctx, chatID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if member == nil || member.MembershipStatus != domain.ActiveMembership {
+		return nil, ErrNotChatMember
+	}
+	return member, nil
+}
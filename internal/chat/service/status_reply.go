@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dykethecreator/GoApp/pkg/domain"
+)
+
+// SendStatusReply posts the caller's reply to a status into their one-to-one chat with the
+// poster, creating the chat on first contact. The message links to the status and keeps
+// preview as its quote, which outlives the status itself.
+func (s *ChatService) SendStatusReply(ctx context.Context, callerID string, preview *domain.StatusReplyPreview, content string, clientMessageID string) (*domain.Message, error) {
+	if preview == nil {
+		return nil, fmt.Errorf("%w: status preview is required", ErrInvalidArgument)
+	}
+	if preview.UserID.String() == callerID {
+		return nil, fmt.Errorf("%w: cannot reply to your own status", ErrInvalidArgument)
+	}
+	chat, _, err := s.CreateChat(ctx, callerID, "", []string{preview.UserID.String()}, false)
+	if err != nil {
+		return nil, err
+	}
+	msg, _, err := s.SendMessage(ctx, callerID, SendMessageInput{
+		ChatID:          chat.ID.String(),
+		ClientMessageID: clientMessageID,
+		ContentType:     domain.TextContent,
+		Content:         content,
+		StatusReply:     preview,
+	})
+	return msg, err
+}
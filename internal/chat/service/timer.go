@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dykethecreator/GoApp/pkg/domain"
+)
+
+// messageTimers are the disappearing-messages timers a chat may use.
+var messageTimers = map[string]time.Duration{
+	"off": 0,
+	"24h": 24 * time.Hour,
+	"7d":  7 * 24 * time.Hour,
+	"90d": 90 * 24 * time.Hour,
+}
+
+// SetMessageTimer sets the chat's disappearing-messages timer ("off", "24h", "7d" or "90d").
+// Messages sent afterwards expire that long after being sent; earlier messages keep their
+// expiry. Either member of a one-to-one chat may change it, in groups only admins.
+func (s *ChatService) SetMessageTimer(ctx context.Context, callerID string, chatID string, timer string) (*domain.Chat, error) {
+	ttl, ok := messageTimers[timer]
+	if !ok {
+		return nil, fmt.Errorf("%w: timer must be off, 24h, 7d or 90d", ErrInvalidArgument)
+	}
+	member, err := s.requireActiveMember(ctx, chatID, callerID)
+	if err != nil {
+		return nil, err
+	}
+	chat, err := s.chatRepo.FindByID(ctx, chatID)
+	if err != nil {
+		return nil, err
+	}
+	if chat == nil {
+		return nil, ErrChatNotFound
+	}
+	if chat.Type == domain.GroupChat && member.Role != domain.AdminRole {
+		return nil, ErrNotGroupAdmin
+	}
+
+	seconds := int(ttl / time.Second)
+	if chat.MessageTimerSeconds == seconds {
+		return chat, nil
+	}
+	if err := s.chatRepo.UpdateMessageTimer(ctx, chatID, seconds); err != nil {
+		return nil, err
+	}
+	chat.MessageTimerSeconds = seconds
+	s.postSystemNotification(ctx, chat.ID, domain.SystemNotification{
+		Event:        domain.MessageTimerEvent,
+		ActorUserID:  &member.UserID,
+		TimerSeconds: &seconds,
+	})
+	return chat, nil
+}
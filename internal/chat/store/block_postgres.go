@@ -0,0 +1,38 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/dykethecreator/GoApp/internal/chat/repository"
+)
+
+// BlockStore implements BlockRepository for PostgreSQL.
+type BlockStore struct {
+	db *sql.DB
+}
+
+func NewBlockStore(db *sql.DB) repository.BlockRepository {
+	return &BlockStore{db: db}
+}
+
+func (s *BlockStore) IsBlockedByAnyMember(ctx context.Context, chatID string, userID string) (bool, error) {
+	q := `SELECT EXISTS (
+		SELECT 1 FROM chat_members cm
+		JOIN blocked_users b ON b.blocker_user_id = cm.user_id
+		WHERE cm.chat_id = $1 AND cm.membership_status = 'active' AND b.blocked_user_id = $2
+	)`
+	var blocked bool
+	err := s.db.QueryRowContext(ctx, q, chatID, userID).Scan(&blocked)
+	return blocked, err
+}
+
+func (s *BlockStore) IsBlockedBetween(ctx context.Context, userA string, userB string) (bool, error) {
+	q := `SELECT EXISTS (
+		SELECT 1 FROM blocked_users
+		WHERE (blocker_user_id = $1 AND blocked_user_id = $2) OR (blocker_user_id = $2 AND blocked_user_id = $1)
+	)`
+	var blocked bool
+	err := s.db.QueryRowContext(ctx, q, userA, userB).Scan(&blocked)
+	return blocked, err
+}
@@ -0,0 +1,54 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/dykethecreator/GoApp/internal/chat/repository"
+	"github.com/dykethecreator/GoApp/pkg/domain"
+)
+
+// CallStore implements CallRepository for PostgreSQL.
+type CallStore struct {
+	db *sql.DB
+}
+
+func NewCallStore(db *sql.DB) repository.CallRepository {
+	return &CallStore{db: db}
+}
+
+func (s *CallStore) Create(ctx context.Context, call *domain.CallLog) error {
+	if call.StartedAt.IsZero() {
+		call.StartedAt = time.Now()
+	}
+	q := `
+	INSERT INTO call_logs (chat_id, caller_user_id, call_type, status, started_at)
+	VALUES ($1,$2,$3,$4,$5)
+	RETURNING id
+	`
+	return s.db.QueryRowContext(ctx, q, call.ChatID, call.CallerUserID, call.CallType, call.Status, call.StartedAt).Scan(&call.ID)
+}
+
+func (s *CallStore) FindByID(ctx context.Context, callID string) (*domain.CallLog, error) {
+	q := `SELECT id, chat_id, caller_user_id, call_type, status, started_at, ended_at FROM call_logs WHERE id = $1`
+	var c domain.CallLog
+	if err := s.db.QueryRowContext(ctx, q, callID).Scan(&c.ID, &c.ChatID, &c.CallerUserID, &c.CallType, &c.Status, &c.StartedAt, &c.EndedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &c, nil
+}
+
+func (s *CallStore) Finish(ctx context.Context, callID string, status domain.CallStatus, endedAt time.Time) (bool, error) {
+	q := `UPDATE call_logs SET status = $2, ended_at = $3 WHERE id = $1 AND status = $4`
+	res, err := s.db.ExecContext(ctx, q, callID, status, endedAt, domain.OngoingCall)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
@@ -0,0 +1,472 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/dykethecreator/GoApp/internal/chat/repository"
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/google/uuid"
+)
+
+// ChatStore implements the ChatRepository interface for PostgreSQL.
+type ChatStore struct {
+	db *sql.DB
+}
+
+// NewChatStore creates a new ChatStore.
+func NewChatStore(db *sql.DB) repository.ChatRepository {
+	return &ChatStore{db: db}
+}
+
+// CreateChat inserts the chat and its initial members in a single transaction.
+func (s *ChatStore) CreateChat(ctx context.Context, chat *domain.Chat, members []domain.ChatMember) (*domain.Chat, error) {
+	if chat.ID == uuid.Nil {
+		chat.ID = uuid.New()
+	}
+	now := time.Now()
+	chat.CreatedAt = now
+	chat.LastMessageAt = now
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	q := `
+		INSERT INTO chats (id, type, group_name, group_icon_url, group_description, created_by_user_id, created_at, last_message_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+	if _, err := tx.ExecContext(ctx, q,
+		chat.ID,
+		chat.Type,
+		chat.GroupName,
+		chat.GroupIconURL,
+		chat.GroupDescription,
+		chat.CreatedByUserID,
+		chat.CreatedAt,
+		chat.LastMessageAt,
+	); err != nil {
+		return nil, err
+	}
+
+	mq := `
+		INSERT INTO chat_members (chat_id, user_id, role, membership_status, is_muted, is_archived, unread_count, joined_at)
+		VALUES ($1, $2, $3, $4, FALSE, FALSE, 0, $5)
+	`
+	for _, m := range members {
+		if _, err := tx.ExecContext(ctx, mq, chat.ID, m.UserID, m.Role, m.MembershipStatus, now); err != nil {
+			return nil, err
+		}
+		if m.MembershipStatus == domain.ActiveMembership {
+			// The creator added everyone else.
+			addedBy := chat.CreatedByUserID.String()
+			if m.UserID == chat.CreatedByUserID {
+				addedBy = ""
+			}
+			if err := enqueueMemberAdded(ctx, tx, chat.ID.String(), m.UserID.String(), addedBy, m.Role, now); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return chat, nil
+}
+
+// FindByID finds a chat by its ID.
+func (s *ChatStore) FindByID(ctx context.Context, chatID string) (*domain.Chat, error) {
+	q := `SELECT id, type, group_name, group_icon_url, group_description, created_by_user_id, created_at, last_message_at, pinned_message_id, message_timer_seconds
+	FROM chats WHERE id = $1`
+
+	var c domain.Chat
+	var lastMessageAt sql.NullTime
+	err := s.db.QueryRowContext(ctx, q, chatID).Scan(
+		&c.ID,
+		&c.Type,
+		&c.GroupName,
+		&c.GroupIconURL,
+		&c.GroupDescription,
+		&c.CreatedByUserID,
+		&c.CreatedAt,
+		&lastMessageAt,
+		&c.PinnedMessageID,
+		&c.MessageTimerSeconds,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil // Chat not found
+		}
+		return nil, err
+	}
+	c.LastMessageAt = lastMessageAt.Time
+	return &c, nil
+}
+
+// FindOneToOne finds the one-to-one chat between two users, if any.
+func (s *ChatStore) FindOneToOne(ctx context.Context, userA string, userB string) (*domain.Chat, error) {
+	q := `SELECT c.id FROM chats c
+	JOIN chat_members a ON a.chat_id = c.id AND a.user_id = $2
+	JOIN chat_members b ON b.chat_id = c.id AND b.user_id = $3
+	WHERE c.type = $1
+	LIMIT 1`
+
+	var id string
+	if err := s.db.QueryRowContext(ctx, q, domain.OneToOneChat, userA, userB).Scan(&id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return s.FindByID(ctx, id)
+}
+
+// memberColumns lists the chat_members columns in the order scanMember reads them. A mute
+// whose muted_until has passed reads as not muted.
+const memberColumns = `chat_id, user_id, role, membership_status,
+	COALESCE(is_muted, FALSE) AND (muted_until IS NULL OR muted_until > NOW()), muted_until,
+	COALESCE(is_archived, FALSE), unarchive_on_message, COALESCE(unread_count, 0), marked_unread, pinned_at, joined_at`
+
+func scanMember(row interface{ Scan(dest ...any) error }) (*domain.ChatMember, error) {
+	var m domain.ChatMember
+	if err := row.Scan(
+		&m.ChatID,
+		&m.UserID,
+		&m.Role,
+		&m.MembershipStatus,
+		&m.IsMuted,
+		&m.MutedUntil,
+		&m.IsArchived,
+		&m.UnarchiveOnMessage,
+		&m.UnreadCount,
+		&m.MarkedUnread,
+		&m.PinnedAt,
+		&m.JoinedAt,
+	); err != nil {
+		return nil, err
+	}
+	if !m.IsMuted {
+		m.MutedUntil = nil
+	}
+	return &m, nil
+}
+
+// FindMember returns the membership of a user in a chat, regardless of its status.
+func (s *ChatStore) FindMember(ctx context.Context, chatID string, userID string) (*domain.ChatMember, error) {
+	q := `SELECT ` + memberColumns + ` FROM chat_members WHERE chat_id = $1 AND user_id = $2`
+	m, err := scanMember(s.db.QueryRowContext(ctx, q, chatID, userID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return m, nil
+}
+
+// ListForUser returns the chats the user is an active member of that match filter: pinned
+// chats first, most recently pinned first, then by most recent activity. The last message
+// is the newest one not deleted "for me" by the user.
+func (s *ChatStore) ListForUser(ctx context.Context, userID string, filter repository.ChatListFilter) ([]*repository.ChatSummary, error) {
+	q := `
+	SELECT c.id, c.type, c.group_name, c.group_icon_url, c.group_description, c.created_by_user_id, c.created_at, c.last_message_at, c.pinned_message_id, c.message_timer_seconds,
+		cm.role, cm.membership_status,
+		COALESCE(cm.is_muted, FALSE) AND (cm.muted_until IS NULL OR cm.muted_until > NOW()), cm.muted_until,
+		COALESCE(cm.is_archived, FALSE), cm.unarchive_on_message, COALESCE(cm.unread_count, 0), cm.marked_unread, cm.pinned_at, cm.joined_at,
+		COALESCE(c.group_name, other.display_name, other.phone_number, ''),
+		lm.id, lm.sender_id, lm.content_type, lm.content, lm.created_at
+	FROM chat_members cm
+	JOIN chats c ON c.id = cm.chat_id
+	LEFT JOIN LATERAL (
+		SELECT u.display_name, u.phone_number FROM chat_members om
+		JOIN users u ON u.id = om.user_id
+		WHERE om.chat_id = c.id AND om.user_id <> cm.user_id
+		LIMIT 1
+	) other ON c.type = 'one_to_one'
+	LEFT JOIN LATERAL (
+		SELECT m.id, m.sender_id, m.content_type, m.content, m.created_at FROM messages m
+		WHERE m.chat_id = c.id
+		AND (m.expires_at IS NULL OR m.expires_at > NOW())
+		AND NOT EXISTS (SELECT 1 FROM message_deletions md WHERE md.message_id = m.id AND md.user_id = cm.user_id)
+		ORDER BY m.created_at DESC, m.id DESC
+		LIMIT 1
+	) lm ON TRUE
+	WHERE cm.user_id = $1 AND cm.membership_status = 'active'
+	AND ($2 = 'all' OR COALESCE(cm.is_archived, FALSE) = ($2 = 'archived'))
+	ORDER BY cm.pinned_at DESC NULLS LAST, c.last_message_at DESC NULLS LAST
+	`
+	rows, err := s.db.QueryContext(ctx, q, userID, string(filter))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	memberID, _ := uuid.Parse(userID)
+	var out []*repository.ChatSummary
+	for rows.Next() {
+		var cs repository.ChatSummary
+		var lastMessageAt sql.NullTime
+		var lmID sql.NullInt64
+		var lmSender *uuid.UUID
+		var lmType, lmContent sql.NullString
+		var lmCreatedAt sql.NullTime
+		if err := rows.Scan(
+			&cs.Chat.ID, &cs.Chat.Type, &cs.Chat.GroupName, &cs.Chat.GroupIconURL, &cs.Chat.GroupDescription,
+			&cs.Chat.CreatedByUserID, &cs.Chat.CreatedAt, &lastMessageAt, &cs.Chat.PinnedMessageID, &cs.Chat.MessageTimerSeconds,
+			&cs.Member.Role, &cs.Member.MembershipStatus, &cs.Member.IsMuted, &cs.Member.MutedUntil,
+			&cs.Member.IsArchived, &cs.Member.UnarchiveOnMessage, &cs.Member.UnreadCount, &cs.Member.MarkedUnread, &cs.Member.PinnedAt, &cs.Member.JoinedAt,
+			&cs.Title,
+			&lmID, &lmSender, &lmType, &lmContent, &lmCreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		cs.Chat.LastMessageAt = lastMessageAt.Time
+		cs.Member.ChatID = cs.Chat.ID
+		cs.Member.UserID = memberID
+		if !cs.Member.IsMuted {
+			cs.Member.MutedUntil = nil
+		}
+		if lmID.Valid {
+			cs.LastMessage = &domain.Message{
+				ID:          lmID.Int64,
+				ChatID:      cs.Chat.ID,
+				SenderID:    lmSender,
+				ContentType: domain.ContentType(lmType.String),
+				Content:     lmContent.String,
+				CreatedAt:   lmCreatedAt.Time,
+			}
+		}
+		out = append(out, &cs)
+	}
+	return out, rows.Err()
+}
+
+func (s *ChatStore) ListActiveMembers(ctx context.Context, chatID string) ([]*domain.ChatMember, error) {
+	q := `SELECT ` + memberColumns + ` FROM chat_members WHERE chat_id = $1 AND membership_status = 'active'
+	ORDER BY joined_at ASC, user_id ASC`
+	rows, err := s.db.QueryContext(ctx, q, chatID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []*domain.ChatMember
+	for rows.Next() {
+		m, err := scanMember(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, m)
+	}
+	return out, rows.Err()
+}
+
+func (s *ChatStore) AddMember(ctx context.Context, member *domain.ChatMember, addedBy string) (bool, error) {
+	if member.JoinedAt.IsZero() {
+		member.JoinedAt = time.Now()
+	}
+	// An active row is left alone so re-adding a member cannot demote them or wipe their state.
+	q := `
+	INSERT INTO chat_members (chat_id, user_id, role, membership_status, is_muted, is_archived, unread_count, joined_at)
+	VALUES ($1, $2, $3, $4, FALSE, FALSE, 0, $5)
+	ON CONFLICT (chat_id, user_id)
+	DO UPDATE SET role = EXCLUDED.role, membership_status = EXCLUDED.membership_status, joined_at = EXCLUDED.joined_at,
+		is_muted = FALSE, muted_until = NULL, is_archived = FALSE, unarchive_on_message = FALSE,
+		pinned_at = NULL, unread_count = 0, marked_unread = FALSE
+	WHERE chat_members.membership_status <> 'active'
+	`
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, q, member.ChatID, member.UserID, member.Role, member.MembershipStatus, member.JoinedAt)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	if n == 0 {
+		return false, nil
+	}
+	if member.MembershipStatus == domain.ActiveMembership {
+		if err := enqueueMemberAdded(ctx, tx, member.ChatID.String(), member.UserID.String(), addedBy, member.Role, member.JoinedAt); err != nil {
+			return false, err
+		}
+	}
+	return true, tx.Commit()
+}
+
+func (s *ChatStore) UpdateMemberRole(ctx context.Context, chatID string, userID string, role domain.ChatMemberRole) error {
+	q := `UPDATE chat_members SET role = $3 WHERE chat_id = $1 AND user_id = $2`
+	_, err := s.db.ExecContext(ctx, q, chatID, userID, role)
+	return err
+}
+
+func (s *ChatStore) UpdateMemberStatus(ctx context.Context, chatID string, userID string, status domain.MembershipStatus) error {
+	q := `UPDATE chat_members SET membership_status = $3 WHERE chat_id = $1 AND user_id = $2`
+	_, err := s.db.ExecContext(ctx, q, chatID, userID, status)
+	return err
+}
+
+func (s *ChatStore) DemoteAdmin(ctx context.Context, chatID string, userID string) (bool, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	members, err := lockActiveMembers(ctx, tx, chatID)
+	if err != nil {
+		return false, err
+	}
+	admins, isAdmin := 0, false
+	for _, m := range members {
+		if m.Role == domain.AdminRole {
+			admins++
+			isAdmin = isAdmin || m.UserID.String() == userID
+		}
+	}
+	if !isAdmin {
+		return false, nil
+	}
+	if admins <= 1 {
+		return false, repository.ErrLastAdmin
+	}
+	q := `UPDATE chat_members SET role = $3 WHERE chat_id = $1 AND user_id = $2`
+	if _, err := tx.ExecContext(ctx, q, chatID, userID, domain.MemberRole); err != nil {
+		return false, err
+	}
+	return true, tx.Commit()
+}
+
+func (s *ChatStore) RemoveMember(ctx context.Context, chatID string, userID string, status domain.MembershipStatus) (*domain.ChatMember, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	members, err := lockActiveMembers(ctx, tx, chatID)
+	if err != nil {
+		return nil, err
+	}
+	var remaining []*domain.ChatMember
+	admins := 0
+	for _, m := range members {
+		if m.UserID.String() == userID {
+			continue
+		}
+		remaining = append(remaining, m)
+		if m.Role == domain.AdminRole {
+			admins++
+		}
+	}
+	q := `UPDATE chat_members SET membership_status = $3 WHERE chat_id = $1 AND user_id = $2`
+	if _, err := tx.ExecContext(ctx, q, chatID, userID, status); err != nil {
+		return nil, err
+	}
+	var successor *domain.ChatMember
+	if len(remaining) > 0 && admins == 0 {
+		successor = remaining[0]
+		q := `UPDATE chat_members SET role = $3 WHERE chat_id = $1 AND user_id = $2`
+		if _, err := tx.ExecContext(ctx, q, chatID, successor.UserID, domain.AdminRole); err != nil {
+			return nil, err
+		}
+		successor.Role = domain.AdminRole
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return successor, nil
+}
+
+// lockActiveMembers returns the chat's active members, longest-standing first, and locks
+// their rows until tx ends. Always locking in this order keeps concurrent callers from
+// deadlocking.
+func lockActiveMembers(ctx context.Context, tx *sql.Tx, chatID string) ([]*domain.ChatMember, error) {
+	q := `SELECT ` + memberColumns + ` FROM chat_members WHERE chat_id = $1 AND membership_status = 'active'
+	ORDER BY joined_at ASC, user_id ASC
+	FOR UPDATE`
+	rows, err := tx.QueryContext(ctx, q, chatID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []*domain.ChatMember
+	for rows.Next() {
+		m, err := scanMember(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, m)
+	}
+	return out, rows.Err()
+}
+
+func (s *ChatStore) UpdateGroupInfo(ctx context.Context, chatID string, name, iconURL, description *string) error {
+	q := `UPDATE chats SET
+		group_name = COALESCE($2, group_name),
+		group_icon_url = COALESCE($3, group_icon_url),
+		group_description = COALESCE($4, group_description)
+	WHERE id = $1`
+	_, err := s.db.ExecContext(ctx, q, chatID, name, iconURL, description)
+	return err
+}
+
+func (s *ChatStore) UpdateMessageTimer(ctx context.Context, chatID string, seconds int) error {
+	_, err := s.db.ExecContext(ctx, `UPDATE chats SET message_timer_seconds = $2 WHERE id = $1`, chatID, seconds)
+	return err
+}
+
+func (s *ChatStore) UpdateMute(ctx context.Context, chatID string, userID string, muted bool, until *time.Time) error {
+	q := `UPDATE chat_members SET is_muted = $3, muted_until = $4 WHERE chat_id = $1 AND user_id = $2`
+	_, err := s.db.ExecContext(ctx, q, chatID, userID, muted, until)
+	return err
+}
+
+// UpdateArchived archives or unarchives the chat for the member; archiving also unpins it.
+func (s *ChatStore) UpdateArchived(ctx context.Context, chatID string, userID string, archived bool, unarchiveOnMessage bool) error {
+	q := `UPDATE chat_members SET
+		is_archived = $3,
+		unarchive_on_message = $4,
+		pinned_at = CASE WHEN $3 THEN NULL ELSE pinned_at END
+	WHERE chat_id = $1 AND user_id = $2`
+	_, err := s.db.ExecContext(ctx, q, chatID, userID, archived, archived && unarchiveOnMessage)
+	return err
+}
+
+func (s *ChatStore) UpdateMarkedUnread(ctx context.Context, chatID string, userID string, unread bool) error {
+	q := `UPDATE chat_members SET marked_unread = $3 WHERE chat_id = $1 AND user_id = $2`
+	_, err := s.db.ExecContext(ctx, q, chatID, userID, unread)
+	return err
+}
+
+// PinChat pins the chat to the top of the member's list unless they already have
+// maxPinned other active chats pinned. Pinning an archived chat also unarchives it.
+func (s *ChatStore) PinChat(ctx context.Context, chatID string, userID string, at time.Time, maxPinned int) (bool, error) {
+	q := `UPDATE chat_members SET pinned_at = $3, is_archived = FALSE, unarchive_on_message = FALSE
+	WHERE chat_id = $1 AND user_id = $2
+	AND (SELECT COUNT(*) FROM chat_members p
+		WHERE p.user_id = $2 AND p.chat_id <> $1 AND p.membership_status = 'active' AND p.pinned_at IS NOT NULL) < $4`
+	res, err := s.db.ExecContext(ctx, q, chatID, userID, at, maxPinned)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
+
+func (s *ChatStore) UnpinChat(ctx context.Context, chatID string, userID string) error {
+	_, err := s.db.ExecContext(ctx, `UPDATE chat_members SET pinned_at = NULL WHERE chat_id = $1 AND user_id = $2`, chatID, userID)
+	return err
+}
@@ -0,0 +1,138 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dykethecreator/GoApp/internal/chat/repository"
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/google/uuid"
+)
+
+// member loads userID's membership of the chat.
+func (c *testChat) member(userID string) (domain.ChatMemberRole, domain.MembershipStatus) {
+	c.t.Helper()
+	var role domain.ChatMemberRole
+	var status domain.MembershipStatus
+	q := `SELECT role, membership_status FROM chat_members WHERE chat_id = $1 AND user_id = $2`
+	if err := c.db.QueryRow(q, c.id, userID).Scan(&role, &status); err != nil {
+		c.t.Fatal(err)
+	}
+	return role, status
+}
+
+func (c *testChat) promote(userID string) {
+	c.t.Helper()
+	if _, err := c.db.Exec(`UPDATE chat_members SET role = 'admin' WHERE chat_id = $1 AND user_id = $2`, c.id, userID); err != nil {
+		c.t.Fatal(err)
+	}
+}
+
+func TestRemoveMemberPromotesSuccessor(t *testing.T) {
+	db := testDB(t)
+	ctx := context.Background()
+	c := newTestChat(t, db)
+	now := time.Now()
+	admin := c.join(now.Add(-3 * time.Hour))
+	eldest := c.join(now.Add(-2 * time.Hour))
+	youngest := c.join(now.Add(-time.Hour))
+	c.promote(admin)
+	s := NewChatStore(db)
+
+	successor, err := s.RemoveMember(ctx, c.id, admin, domain.LeftMembership)
+	if err != nil {
+		t.Fatalf("RemoveMember: %v", err)
+	}
+	if successor == nil || successor.UserID.String() != eldest {
+		t.Fatalf("successor = %+v, want the longest-standing member %s", successor, eldest)
+	}
+	if role, _ := c.member(eldest); role != domain.AdminRole {
+		t.Errorf("successor role = %q, want admin", role)
+	}
+	if _, status := c.member(admin); status != domain.LeftMembership {
+		t.Errorf("leaver status = %q, want left", status)
+	}
+
+	// The group still has an admin, so removing a member promotes no one.
+	successor, err = s.RemoveMember(ctx, c.id, youngest, domain.KickedMembership)
+	if err != nil {
+		t.Fatalf("RemoveMember: %v", err)
+	}
+	if successor != nil {
+		t.Errorf("successor = %+v, want none", successor)
+	}
+}
+
+func TestDemoteAdminKeepsLastAdmin(t *testing.T) {
+	db := testDB(t)
+	ctx := context.Background()
+	c := newTestChat(t, db)
+	now := time.Now()
+	first := c.join(now.Add(-time.Hour))
+	second := c.join(now.Add(-time.Hour))
+	c.promote(first)
+	s := NewChatStore(db)
+
+	if _, err := s.DemoteAdmin(ctx, c.id, first); !errors.Is(err, repository.ErrLastAdmin) {
+		t.Fatalf("DemoteAdmin of the only admin: err = %v, want ErrLastAdmin", err)
+	}
+	c.promote(second)
+	if demoted, err := s.DemoteAdmin(ctx, c.id, first); err != nil || !demoted {
+		t.Fatalf("DemoteAdmin = %v, %v, want true", demoted, err)
+	}
+	if role, _ := c.member(first); role != domain.MemberRole {
+		t.Errorf("role = %q, want member", role)
+	}
+}
+
+func TestAddMemberLeavesActiveMembershipAlone(t *testing.T) {
+	db := testDB(t)
+	ctx := context.Background()
+	c := newTestChat(t, db)
+	admin := c.join(time.Now().Add(-time.Hour))
+	c.promote(admin)
+	s := NewChatStore(db)
+	add := func() bool {
+		t.Helper()
+		m := &domain.ChatMember{ChatID: uuid.MustParse(c.id), UserID: uuid.MustParse(admin), Role: domain.MemberRole, MembershipStatus: domain.ActiveMembership}
+		added, err := s.AddMember(ctx, m, admin)
+		if err != nil {
+			t.Fatalf("AddMember: %v", err)
+		}
+		return added
+	}
+	memberAdded := func() int {
+		t.Helper()
+		var n int
+		q := `SELECT COUNT(*) FROM outbox_events WHERE aggregate_id = $1 AND event_type = 'events.MemberAdded'`
+		if err := db.QueryRow(q, c.id).Scan(&n); err != nil {
+			t.Fatal(err)
+		}
+		return n
+	}
+
+	if add() {
+		t.Error("AddMember of an active member reported an addition")
+	}
+	if role, _ := c.member(admin); role != domain.AdminRole {
+		t.Errorf("role = %q, want the admin role kept", role)
+	}
+	if n := memberAdded(); n != 0 {
+		t.Errorf("%d MemberAdded events for an active member, want 0", n)
+	}
+
+	if _, err := s.RemoveMember(ctx, c.id, admin, domain.LeftMembership); err != nil {
+		t.Fatalf("RemoveMember: %v", err)
+	}
+	if !add() {
+		t.Error("AddMember of a former member reported no addition")
+	}
+	if role, status := c.member(admin); role != domain.MemberRole || status != domain.ActiveMembership {
+		t.Errorf("membership = %q, %q, want an active member", role, status)
+	}
+	if n := memberAdded(); n != 1 {
+		t.Errorf("%d MemberAdded events after rejoining, want 1", n)
+	}
+}
@@ -0,0 +1,102 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/dykethecreator/GoApp/internal/chat/repository"
+	"github.com/dykethecreator/GoApp/pkg/domain"
+)
+
+// InviteStore implements InviteRepository for PostgreSQL.
+type InviteStore struct {
+	db *sql.DB
+}
+
+func NewInviteStore(db *sql.DB) repository.InviteRepository {
+	return &InviteStore{db: db}
+}
+
+func (s *InviteStore) FindByChat(ctx context.Context, chatID string) (*domain.GroupInviteLink, error) {
+	q := `SELECT chat_id, code, approval_required, created_by_user_id, created_at FROM group_invite_links WHERE chat_id = $1`
+	return s.findLink(ctx, q, chatID)
+}
+
+func (s *InviteStore) FindByCode(ctx context.Context, code string) (*domain.GroupInviteLink, error) {
+	q := `SELECT chat_id, code, approval_required, created_by_user_id, created_at FROM group_invite_links WHERE code = $1`
+	return s.findLink(ctx, q, code)
+}
+
+func (s *InviteStore) findLink(ctx context.Context, q string, arg string) (*domain.GroupInviteLink, error) {
+	var l domain.GroupInviteLink
+	if err := s.db.QueryRowContext(ctx, q, arg).Scan(&l.ChatID, &l.Code, &l.ApprovalRequired, &l.CreatedByUserID, &l.CreatedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &l, nil
+}
+
+func (s *InviteStore) UpsertLink(ctx context.Context, link *domain.GroupInviteLink) error {
+	if link.CreatedAt.IsZero() {
+		link.CreatedAt = time.Now()
+	}
+	q := `
+	INSERT INTO group_invite_links (chat_id, code, approval_required, created_by_user_id, created_at)
+	VALUES ($1,$2,$3,$4,$5)
+	ON CONFLICT (chat_id)
+	DO UPDATE SET code = EXCLUDED.code, approval_required = EXCLUDED.approval_required,
+		created_by_user_id = EXCLUDED.created_by_user_id, created_at = EXCLUDED.created_at
+	`
+	_, err := s.db.ExecContext(ctx, q, link.ChatID, link.Code, link.ApprovalRequired, link.CreatedByUserID, link.CreatedAt)
+	return err
+}
+
+func (s *InviteStore) CreateJoinRequest(ctx context.Context, req *domain.GroupJoinRequest) error {
+	if req.RequestedAt.IsZero() {
+		req.RequestedAt = time.Now()
+	}
+	q := `INSERT INTO group_join_requests (chat_id, user_id, requested_at) VALUES ($1,$2,$3) ON CONFLICT DO NOTHING`
+	_, err := s.db.ExecContext(ctx, q, req.ChatID, req.UserID, req.RequestedAt)
+	return err
+}
+
+func (s *InviteStore) FindJoinRequest(ctx context.Context, chatID string, userID string) (*domain.GroupJoinRequest, error) {
+	q := `SELECT chat_id, user_id, requested_at FROM group_join_requests WHERE chat_id = $1 AND user_id = $2`
+	var r domain.GroupJoinRequest
+	if err := s.db.QueryRowContext(ctx, q, chatID, userID).Scan(&r.ChatID, &r.UserID, &r.RequestedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &r, nil
+}
+
+func (s *InviteStore) ListJoinRequests(ctx context.Context, chatID string) ([]*domain.GroupJoinRequest, error) {
+	q := `SELECT chat_id, user_id, requested_at FROM group_join_requests WHERE chat_id = $1 ORDER BY requested_at ASC`
+	rows, err := s.db.QueryContext(ctx, q, chatID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []*domain.GroupJoinRequest
+	for rows.Next() {
+		var r domain.GroupJoinRequest
+		if err := rows.Scan(&r.ChatID, &r.UserID, &r.RequestedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, &r)
+	}
+	return out, rows.Err()
+}
+
+func (s *InviteStore) DeleteJoinRequest(ctx context.Context, chatID string, userID string) error {
+	q := `DELETE FROM group_join_requests WHERE chat_id = $1 AND user_id = $2`
+	_, err := s.db.ExecContext(ctx, q, chatID, userID)
+	return err
+}
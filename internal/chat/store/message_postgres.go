@@ -0,0 +1,598 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dykethecreator/GoApp/internal/chat/repository"
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	pb "github.com/dykethecreator/GoApp/proto"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// messageColumns is the column list scanned by scanMessage.
+const messageColumns = `id, chat_id, sender_id, content_type, content, media_url, media_metadata, reply_to_message_id, created_at, edited_at, deleted_at, deleted_by_user_id, client_message_id, forwarded, forward_count, expires_at, reply_to_status_id, status_preview`
+
+// rowScanner is satisfied by both *sql.Row and *sql.Rows.
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanMessage(row rowScanner) (*domain.Message, error) {
+	var m domain.Message
+	var content sql.NullString
+	var metadata, statusPreview []byte
+	if err := row.Scan(
+		&m.ID,
+		&m.ChatID,
+		&m.SenderID,
+		&m.ContentType,
+		&content,
+		&m.MediaURL,
+		&metadata,
+		&m.ReplyToMessageID,
+		&m.CreatedAt,
+		&m.EditedAt,
+		&m.DeletedAt,
+		&m.DeletedByUserID,
+		&m.ClientMessageID,
+		&m.Forwarded,
+		&m.ForwardCount,
+		&m.ExpiresAt,
+		&m.ReplyToStatusID,
+		&statusPreview,
+	); err != nil {
+		return nil, err
+	}
+	m.Content = content.String
+	if len(metadata) > 0 {
+		m.MediaMetadata = metadata
+	}
+	if len(statusPreview) > 0 {
+		var p domain.StatusReplyPreview
+		if err := json.Unmarshal(statusPreview, &p); err != nil {
+			return nil, err
+		}
+		m.StatusPreview = &p
+	}
+	return &m, nil
+}
+
+// MessageStore implements MessageRepository for PostgreSQL.
+type MessageStore struct {
+	db *sql.DB
+	// searchConfig is the text search configuration used both to fill
+	// content_search_vector and to parse search queries.
+	searchConfig string
+}
+
+// NewMessageStore creates a MessageStore. searchConfig names a Postgres text search
+// configuration (e.g. "simple", "turkish", "english"); empty means "simple".
+func NewMessageStore(db *sql.DB, searchConfig string) repository.MessageRepository {
+	return newMessageStore(db, searchConfig)
+}
+
+func newMessageStore(db *sql.DB, searchConfig string) *MessageStore {
+	if searchConfig == "" {
+		searchConfig = "simple"
+	}
+	return &MessageStore{db: db, searchConfig: searchConfig}
+}
+
+// searchableText returns the text to index for a message, or nil when it should not be
+// searchable (system notifications, polls, deleted messages).
+func searchableText(contentType domain.ContentType, content string) interface{} {
+	switch contentType {
+	case domain.TextContent, domain.ImageContent, domain.VideoContent, domain.AudioContent, domain.FileContent:
+		if content != "" {
+			return content
+		}
+	}
+	return nil
+}
+
+func (s *MessageStore) CreateMessage(ctx context.Context, msg *domain.Message) (*domain.Message, bool, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	defer tx.Rollback()
+
+	stored, created, err := s.insertMessage(ctx, tx, msg)
+	if err != nil || !created {
+		return stored, created, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, false, err
+	}
+	return stored, true, nil
+}
+
+func (s *MessageStore) CreateMessages(ctx context.Context, msgs []*domain.Message) ([]*domain.Message, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	out := make([]*domain.Message, 0, len(msgs))
+	for _, msg := range msgs {
+		stored, _, err := s.insertMessage(ctx, tx, msg)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, stored)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// insertMessage stores msg in tx along with its sync event, MessageSent event and the
+// members' unread counts. A retry of an already stored client message returns that
+// message and false, changing nothing.
+func (s *MessageStore) insertMessage(ctx context.Context, tx *sql.Tx, msg *domain.Message) (*domain.Message, bool, error) {
+	if msg.CreatedAt.IsZero() {
+		msg.CreatedAt = time.Now()
+	}
+	var metadata interface{}
+	if len(msg.MediaMetadata) > 0 {
+		metadata = []byte(msg.MediaMetadata)
+	}
+	var statusPreview interface{}
+	if msg.StatusPreview != nil {
+		b, err := json.Marshal(msg.StatusPreview)
+		if err != nil {
+			return nil, false, err
+		}
+		statusPreview = b
+	}
+
+	q := `
+	INSERT INTO messages (chat_id, sender_id, content_type, content, media_url, media_metadata, reply_to_message_id, created_at, client_message_id, content_search_vector, forwarded, forward_count, expires_at, reply_to_status_id, status_preview)
+	VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9, to_tsvector($10::regconfig, $11::text), $12, $13,
+		(SELECT $8::timestamptz + message_timer_seconds * INTERVAL '1 second' FROM chats
+		 WHERE id = $1 AND message_timer_seconds > 0 AND $3 <> 'system_notification'),
+		$14, $15)
+	ON CONFLICT (chat_id, sender_id, client_message_id) DO NOTHING
+	RETURNING ` + messageColumns
+	stored, err := scanMessage(tx.QueryRowContext(ctx, q,
+		msg.ChatID,
+		msg.SenderID,
+		msg.ContentType,
+		msg.Content,
+		msg.MediaURL,
+		metadata,
+		msg.ReplyToMessageID,
+		msg.CreatedAt,
+		msg.ClientMessageID,
+		s.searchConfig,
+		searchableText(msg.ContentType, msg.Content),
+		msg.Forwarded,
+		msg.ForwardCount,
+		msg.ReplyToStatusID,
+		statusPreview,
+	))
+	if errors.Is(err, sql.ErrNoRows) {
+		// Idempotent retry: return the message stored by the first attempt.
+		q := `SELECT ` + messageColumns + ` FROM messages WHERE chat_id = $1 AND sender_id = $2 AND client_message_id = $3`
+		existing, ferr := scanMessage(tx.QueryRowContext(ctx, q, msg.ChatID, msg.SenderID, msg.ClientMessageID))
+		if ferr != nil {
+			return nil, false, ferr
+		}
+		return existing, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE chats SET last_message_at = $2 WHERE id = $1`, stored.ChatID, stored.CreatedAt); err != nil {
+		return nil, false, err
+	}
+	if err := logSyncEvent(ctx, tx, stored.ChatID, nil, domain.SyncMessage, stored.ID, nil); err != nil {
+		return nil, false, err
+	}
+	if err := enqueueMessageSent(ctx, tx, stored); err != nil {
+		return nil, false, err
+	}
+	// System notifications are never unread, as in MarkReadUpTo's recount.
+	uq := `UPDATE chat_members SET unread_count = COALESCE(unread_count, 0) + CASE WHEN $3 THEN 0 ELSE 1 END,
+		is_archived = CASE WHEN unarchive_on_message THEN FALSE ELSE is_archived END,
+		unarchive_on_message = FALSE
+	WHERE chat_id = $1 AND membership_status = 'active' AND ($2::uuid IS NULL OR user_id <> $2)`
+	if _, err := tx.ExecContext(ctx, uq, stored.ChatID, stored.SenderID, stored.ContentType == domain.SystemNotificationContent); err != nil {
+		return nil, false, err
+	}
+	return stored, true, nil
+}
+
+func (s *MessageStore) FindByID(ctx context.Context, messageID int64) (*domain.Message, error) {
+	q := `SELECT ` + messageColumns + ` FROM messages WHERE id = $1`
+	m, err := scanMessage(s.db.QueryRowContext(ctx, q, messageID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return m, nil
+}
+
+func (s *MessageStore) FindVisible(ctx context.Context, messageIDs []int64, userID string) ([]*domain.Message, error) {
+	if len(messageIDs) == 0 {
+		return nil, nil
+	}
+	q := `SELECT ` + prefixedMessageColumns("m") + ` FROM messages m
+	WHERE m.id = ANY($1)
+	AND (m.expires_at IS NULL OR m.expires_at > NOW())
+	AND NOT EXISTS (SELECT 1 FROM message_deletions md WHERE md.message_id = m.id AND md.user_id = $2)`
+	rows, err := s.db.QueryContext(ctx, q, pq.Array(messageIDs), userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []*domain.Message
+	for rows.Next() {
+		m, err := scanMessage(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, m)
+	}
+	return out, rows.Err()
+}
+
+func (s *MessageStore) UpdateContent(ctx context.Context, messageID int64, content string, editedAt time.Time) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	q := `UPDATE messages SET content = $2, edited_at = $3,
+		content_search_vector = CASE WHEN content_type IN ('text', 'image', 'video', 'audio', 'file') AND $2 <> ''
+			THEN to_tsvector($4::regconfig, $2) END
+	WHERE id = $1 AND deleted_at IS NULL
+	RETURNING chat_id, sender_id`
+	var chatID string
+	var senderID sql.NullString
+	err = tx.QueryRowContext(ctx, q, messageID, content, editedAt, s.searchConfig).Scan(&chatID, &senderID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	payload := domain.SyncEditPayload{Content: content, EditedAt: editedAt}
+	if err := logSyncEvent(ctx, tx, chatID, nil, domain.SyncEdit, messageID, payload); err != nil {
+		return err
+	}
+	recipients, err := activeMemberIDs(ctx, tx, chatID)
+	if err != nil {
+		return err
+	}
+	ev := &pb.MessageEdited{
+		ChatId:           chatID,
+		MessageId:        messageID,
+		EditorUserId:     senderID.String, // only senders edit their messages
+		Content:          content,
+		EditedAt:         formatEventTime(editedAt),
+		RecipientUserIds: recipients,
+	}
+	if err := enqueueChatEvent(ctx, tx, chatID, ev, editedAt); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (s *MessageStore) DeleteForEveryone(ctx context.Context, messageID int64, deletedBy string, deletedAt time.Time) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	q := `UPDATE messages
+	SET content_type = $2, content = NULL, media_url = NULL, media_metadata = NULL, content_search_vector = NULL,
+		status_preview = NULL, deleted_at = $3, deleted_by_user_id = $4
+	WHERE id = $1 AND deleted_at IS NULL
+	RETURNING chat_id`
+	var chatID string
+	err = tx.QueryRowContext(ctx, q, messageID, domain.DeletedContent, deletedAt, deletedBy).Scan(&chatID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil // already deleted
+	}
+	if err != nil {
+		return err
+	}
+	deleter, err := uuid.Parse(deletedBy)
+	if err != nil {
+		return err
+	}
+	payload := domain.SyncDeletePayload{DeletedByUserID: &deleter, DeletedAt: deletedAt}
+	if err := logSyncEvent(ctx, tx, chatID, nil, domain.SyncDelete, messageID, payload); err != nil {
+		return err
+	}
+	recipients, err := activeMemberIDs(ctx, tx, chatID)
+	if err != nil {
+		return err
+	}
+	ev := &pb.MessageDeleted{
+		ChatId:           chatID,
+		MessageId:        messageID,
+		DeletedByUserId:  deletedBy,
+		ForEveryone:      true,
+		DeletedAt:        formatEventTime(deletedAt),
+		RecipientUserIds: recipients,
+	}
+	if err := enqueueChatEvent(ctx, tx, chatID, ev, deletedAt); err != nil {
+		return err
+	}
+	// Reactions to and pins of a tombstone are meaningless.
+	if _, err := tx.ExecContext(ctx, `DELETE FROM message_reactions WHERE message_id = $1`, messageID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM pinned_messages WHERE message_id = $1`, messageID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (s *MessageStore) DeleteForUser(ctx context.Context, messageID int64, userID string) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	q := `INSERT INTO message_deletions (message_id, user_id) VALUES ($1, $2) ON CONFLICT DO NOTHING`
+	res, err := tx.ExecContext(ctx, q, messageID, userID)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil || n == 0 {
+		return err // nothing new to sync when it was already hidden
+	}
+	var chatID string
+	if err := tx.QueryRowContext(ctx, `SELECT chat_id FROM messages WHERE id = $1`, messageID).Scan(&chatID); err != nil {
+		return err
+	}
+	user, err := uuid.Parse(userID)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	payload := domain.SyncDeletePayload{DeletedByUserID: &user, DeletedAt: now}
+	if err := logSyncEvent(ctx, tx, chatID, userID, domain.SyncDeleteForMe, messageID, payload); err != nil {
+		return err
+	}
+	ev := &pb.MessageDeleted{
+		ChatId:           chatID,
+		MessageId:        messageID,
+		DeletedByUserId:  userID,
+		DeletedAt:        formatEventTime(now),
+		RecipientUserIds: []string{userID}, // the user's other devices
+	}
+	if err := enqueueChatEvent(ctx, tx, chatID, ev, now); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (s *MessageStore) DeleteExpired(ctx context.Context, now time.Time, limit int) (int, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	// SKIP LOCKED lets several workers sweep concurrently without contending on a batch.
+	q := `SELECT id, chat_id FROM messages WHERE expires_at <= $1 AND deleted_at IS NULL
+	ORDER BY expires_at LIMIT $2 FOR UPDATE SKIP LOCKED`
+	rows, err := tx.QueryContext(ctx, q, now, limit)
+	if err != nil {
+		return 0, err
+	}
+	var ids []int64
+	var chatIDs []string
+	for rows.Next() {
+		var id int64
+		var chatID string
+		if err := rows.Scan(&id, &chatID); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		ids = append(ids, id)
+		chatIDs = append(chatIDs, chatID)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	// Devices learn of the expiry as of a deletion for everyone, with no deleter.
+	payload := domain.SyncDeletePayload{DeletedAt: now}
+	recipients := map[string][]string{}
+	for i, id := range ids {
+		chatID := chatIDs[i]
+		if err := logSyncEvent(ctx, tx, chatID, nil, domain.SyncDelete, id, payload); err != nil {
+			return 0, err
+		}
+		members, ok := recipients[chatID]
+		if !ok {
+			if members, err = activeMemberIDs(ctx, tx, chatID); err != nil {
+				return 0, err
+			}
+			recipients[chatID] = members
+		}
+		ev := &pb.MessageDeleted{
+			ChatId:           chatID,
+			MessageId:        id,
+			ForEveryone:      true,
+			DeletedAt:        formatEventTime(now),
+			RecipientUserIds: members,
+		}
+		if err := enqueueChatEvent(ctx, tx, chatID, ev, now); err != nil {
+			return 0, err
+		}
+	}
+
+	// The messages stay as tombstones, as after a deletion for everyone, so replies keep
+	// pointing at them; only what hangs off them goes.
+	batch := pq.Array(ids)
+	tombstone := `UPDATE messages
+	SET content_type = $2, content = NULL, media_url = NULL, media_metadata = NULL, content_search_vector = NULL,
+		status_preview = NULL, deleted_at = $3
+	WHERE id = ANY($1)`
+	if _, err := tx.ExecContext(ctx, tombstone, batch, domain.DeletedContent, now); err != nil {
+		return 0, err
+	}
+	for _, stmt := range []string{
+		`DELETE FROM message_statuses WHERE message_id = ANY($1)`,
+		`DELETE FROM message_reactions WHERE message_id = ANY($1)`,
+		`DELETE FROM message_deletions WHERE message_id = ANY($1)`,
+		`DELETE FROM poll_votes WHERE poll_id IN (SELECT id FROM polls WHERE message_id = ANY($1))`,
+		`DELETE FROM poll_options WHERE poll_id IN (SELECT id FROM polls WHERE message_id = ANY($1))`,
+		`DELETE FROM polls WHERE message_id = ANY($1)`,
+		`DELETE FROM pinned_messages WHERE message_id = ANY($1)`,
+		`UPDATE chats SET pinned_message_id = NULL WHERE pinned_message_id = ANY($1)`,
+	} {
+		if _, err := tx.ExecContext(ctx, stmt, batch); err != nil {
+			return 0, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return len(ids), nil
+}
+
+func (s *MessageStore) ListByChat(ctx context.Context, chatID string, userID string, before *repository.MessageCursor, limit int) ([]*domain.Message, error) {
+	args := []interface{}{chatID, userID, limit}
+	cond := ""
+	if before != nil {
+		// Row comparison keeps the scan on the (chat_id, created_at DESC) index with id as tie-breaker.
+		cond = ` AND (m.created_at, m.id) < ($4, $5)`
+		args = append(args, before.CreatedAt, before.ID)
+	}
+	q := `SELECT ` + prefixedMessageColumns("m") + ` FROM messages m
+	WHERE m.chat_id = $1
+	AND (m.expires_at IS NULL OR m.expires_at > NOW())
+	AND NOT EXISTS (SELECT 1 FROM message_deletions md WHERE md.message_id = m.id AND md.user_id = $2)` + cond + `
+	ORDER BY m.created_at DESC, m.id DESC
+	LIMIT $3`
+
+	rows, err := s.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []*domain.Message
+	for rows.Next() {
+		m, err := scanMessage(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, m)
+	}
+	return out, rows.Err()
+}
+
+// snippetOptions configures ts_headline; clients render text between <mark> tags highlighted.
+const snippetOptions = `StartSel=<mark>, StopSel=</mark>, MinWords=5, MaxWords=20, MaxFragments=2, FragmentDelimiter=" … "`
+
+// escapedContent is a message's content with &, < and > HTML-escaped. Snippets are cut
+// from it, so the <mark> tags are the only markup they contain.
+const escapedContent = `replace(replace(replace(COALESCE(m.content, ''), '&', '&amp;'), '<', '&lt;'), '>', '&gt;')`
+
+func (s *MessageStore) Search(ctx context.Context, userID string, params repository.MessageSearch) ([]*repository.SearchHit, error) {
+	args := []interface{}{userID, s.searchConfig, params.Query}
+	arg := func(v interface{}) string {
+		args = append(args, v)
+		return "$" + strconv.Itoa(len(args))
+	}
+
+	var cond strings.Builder
+	if params.ChatID != "" {
+		cond.WriteString(" AND m.chat_id = " + arg(params.ChatID))
+	}
+	if params.SenderID != "" {
+		cond.WriteString(" AND m.sender_id = " + arg(params.SenderID))
+	}
+	if len(params.ContentTypes) > 0 {
+		types := make([]string, len(params.ContentTypes))
+		for i, t := range params.ContentTypes {
+			types[i] = string(t)
+		}
+		cond.WriteString(" AND m.content_type = ANY(" + arg(pq.Array(types)) + ")")
+	}
+	if params.From != nil {
+		cond.WriteString(" AND m.created_at >= " + arg(*params.From))
+	}
+	if params.To != nil {
+		cond.WriteString(" AND m.created_at < " + arg(*params.To))
+	}
+	if params.Before != nil {
+		cond.WriteString(" AND (m.created_at, m.id) < (" + arg(params.Before.CreatedAt) + ", " + arg(params.Before.ID) + ")")
+	}
+
+	q := `SELECT ` + prefixedMessageColumns("m") + `, ts_headline($2::regconfig, ` + escapedContent + `, tsq, ` + arg(snippetOptions) + `)
+	FROM messages m
+	JOIN chat_members cm ON cm.chat_id = m.chat_id AND cm.user_id = $1 AND cm.membership_status = 'active'
+	CROSS JOIN websearch_to_tsquery($2::regconfig, $3) tsq
+	WHERE m.content_search_vector @@ tsq
+	AND m.deleted_at IS NULL
+	AND (m.expires_at IS NULL OR m.expires_at > NOW())
+	AND NOT EXISTS (SELECT 1 FROM message_deletions md WHERE md.message_id = m.id AND md.user_id = $1)` + cond.String() + `
+	ORDER BY m.created_at DESC, m.id DESC
+	LIMIT ` + arg(params.Limit)
+
+	rows, err := s.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []*repository.SearchHit
+	for rows.Next() {
+		var hit repository.SearchHit
+		m, err := scanMessage(snippetScanner{rows, &hit.Snippet})
+		if err != nil {
+			return nil, err
+		}
+		hit.Message = m
+		out = append(out, &hit)
+	}
+	return out, rows.Err()
+}
+
+// snippetScanner appends the trailing snippet column to the message columns scanned by scanMessage.
+type snippetScanner struct {
+	rows    *sql.Rows
+	snippet *string
+}
+
+func (s snippetScanner) Scan(dest ...interface{}) error {
+	return s.rows.Scan(append(dest, s.snippet)...)
+}
+
+// prefixedMessageColumns qualifies messageColumns with a table alias.
+func prefixedMessageColumns(alias string) string {
+	cols := strings.Split(messageColumns, ", ")
+	for i, c := range cols {
+		cols[i] = alias + "." + c
+	}
+	return strings.Join(cols, ", ")
+}
@@ -0,0 +1,153 @@
+package store
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dykethecreator/GoApp/internal/chat/repository"
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	pb "github.com/dykethecreator/GoApp/proto"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestDeleteExpiredAnnouncesDeletion(t *testing.T) {
+	db := testDB(t)
+	ctx := context.Background()
+	c := newTestChat(t, db)
+	now := time.Now()
+	sender := c.join(now.Add(-time.Hour))
+	c.join(now.Add(-time.Hour))
+
+	past := now.Add(-time.Second)
+	expired := c.post(sender, now.Add(-time.Minute), &past)
+	kept := c.post(sender, now.Add(-time.Minute), nil)
+	if _, err := db.Exec(`UPDATE messages SET reply_to_message_id = $2 WHERE id = $1`, kept, expired); err != nil {
+		t.Fatal(err)
+	}
+	var pollID string
+	q := `INSERT INTO polls (chat_id, created_by_user_id, question_text, message_id) VALUES ($1, $2, 'q', $3) RETURNING id`
+	if err := db.QueryRow(q, c.id, sender, expired).Scan(&pollID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(`INSERT INTO poll_options (poll_id, option_text) VALUES ($1, 'a'), ($1, 'b')`, pollID); err != nil {
+		t.Fatal(err)
+	}
+
+	messages := NewMessageStore(db, "")
+	if _, err := messages.DeleteExpired(ctx, now, 1000); err != nil {
+		t.Fatalf("DeleteExpired: %v", err)
+	}
+
+	// The expired message stays as a tombstone the reply still points at.
+	tomb, err := messages.FindByID(ctx, expired)
+	if err != nil || tomb == nil {
+		t.Fatalf("FindByID(expired) = %v, %v", tomb, err)
+	}
+	if tomb.DeletedAt == nil || tomb.DeletedByUserID != nil || tomb.ContentType != domain.DeletedContent || tomb.Content != "" {
+		t.Errorf("expired message = %+v, want a tombstone without a deleter", tomb)
+	}
+	reply, err := messages.FindByID(ctx, kept)
+	if err != nil || reply == nil {
+		t.Fatalf("FindByID(kept) = %v, %v", reply, err)
+	}
+	if reply.DeletedAt != nil || reply.ReplyToMessageID == nil || *reply.ReplyToMessageID != expired {
+		t.Errorf("reply = %+v, want it untouched and still replying to %d", reply, expired)
+	}
+	if n, err := messages.DeleteExpired(ctx, now, 1000); err != nil || n != 0 {
+		t.Errorf("second DeleteExpired = %d, %v; want the tombstone left alone", n, err)
+	}
+	var polls int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM polls WHERE id = $1`, pollID).Scan(&polls); err != nil {
+		t.Fatal(err)
+	}
+	if polls != 0 {
+		t.Error("the expired message's poll was kept")
+	}
+
+	var kind domain.SyncEventKind
+	err = db.QueryRow(`SELECT kind FROM sync_events WHERE chat_id = $1 AND message_id = $2 AND user_id IS NULL ORDER BY seq DESC LIMIT 1`, c.id, expired).Scan(&kind)
+	if err != nil {
+		t.Fatalf("sync event of the expired message: %v", err)
+	}
+	if kind != domain.SyncDelete {
+		t.Errorf("sync event kind = %q, want %q", kind, domain.SyncDelete)
+	}
+
+	var data []byte
+	q = `SELECT envelope FROM outbox_events WHERE aggregate_id = $1 AND event_type = 'events.MessageDeleted' ORDER BY id DESC LIMIT 1`
+	if err := db.QueryRow(q, c.id).Scan(&data); err != nil {
+		t.Fatalf("MessageDeleted outbox event: %v", err)
+	}
+	var env pb.EventEnvelope
+	var ev pb.MessageDeleted
+	if err := proto.Unmarshal(data, &env); err != nil {
+		t.Fatal(err)
+	}
+	if err := proto.Unmarshal(env.GetPayload(), &ev); err != nil {
+		t.Fatal(err)
+	}
+	if ev.GetMessageId() != expired || !ev.GetForEveryone() || ev.GetDeletedByUserId() != "" || len(ev.GetRecipientUserIds()) != 2 {
+		t.Errorf("MessageDeleted = %v", &ev)
+	}
+}
+
+func TestSearchSnippetEscapesContent(t *testing.T) {
+	db := testDB(t)
+	ctx := context.Background()
+	c := newTestChat(t, db)
+	now := time.Now()
+	reader := c.join(now.Add(-time.Hour))
+	id := c.post(reader, now.Add(-time.Minute), nil)
+	q := `UPDATE messages SET content = $2, content_search_vector = to_tsvector('simple', $2) WHERE id = $1`
+	if _, err := db.Exec(q, id, `<img src=x onerror=alert(1)> hello & bye`); err != nil {
+		t.Fatal(err)
+	}
+
+	hits, err := NewMessageStore(db, "simple").Search(ctx, reader, repository.MessageSearch{ChatID: c.id, Query: "hello", Limit: 10})
+	if err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+	if len(hits) != 1 {
+		t.Fatalf("Search found %d messages, want 1", len(hits))
+	}
+	snippet := hits[0].Snippet
+	if strings.Contains(snippet, "<img") || !strings.Contains(snippet, "&lt;img") || !strings.Contains(snippet, "&amp; bye") {
+		t.Errorf("Snippet = %q, want the content HTML-escaped", snippet)
+	}
+	if !strings.Contains(snippet, "<mark>hello</mark>") {
+		t.Errorf("Snippet = %q, want hello highlighted", snippet)
+	}
+}
+
+func TestCreateMessagesStoresAllOrNone(t *testing.T) {
+	db := testDB(t)
+	ctx := context.Background()
+	c := newTestChat(t, db)
+	sender, err := uuid.Parse(c.join(time.Now().Add(-time.Hour)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	chatID, err := uuid.Parse(c.id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	copyTo := func(chat uuid.UUID) *domain.Message {
+		return &domain.Message{ChatID: chat, SenderID: &sender, ContentType: domain.TextContent, Content: "fwd", Forwarded: true, ForwardCount: 1}
+	}
+
+	// The second copy names a chat that does not exist, so the first must not be kept.
+	_, err = NewMessageStore(db, "").CreateMessages(ctx, []*domain.Message{copyTo(chatID), copyTo(uuid.New())})
+	if err == nil {
+		t.Fatal("CreateMessages stored a copy in a missing chat")
+	}
+	var n int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM messages WHERE chat_id = $1 AND content = 'fwd'`, c.id).Scan(&n); err != nil {
+		t.Fatal(err)
+	}
+	if n != 0 {
+		t.Errorf("%d copies left behind by the failed call, want 0", n)
+	}
+}
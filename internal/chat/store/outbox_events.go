@@ -0,0 +1,183 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/dykethecreator/GoApp/internal/chat/repository"
+	"github.com/dykethecreator/GoApp/internal/outbox"
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/dykethecreator/GoApp/pkg/eventbus"
+	pb "github.com/dykethecreator/GoApp/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+// outboxProducer names the chat service as the producer of the events it records.
+const outboxProducer = "chat_service"
+
+// enqueueChatEvent records a domain event of chatID in the outbox, inside tx.
+func enqueueChatEvent(ctx context.Context, tx *sql.Tx, chatID string, event proto.Message, at time.Time) error {
+	return outbox.EnqueueEvent(ctx, tx, eventbus.TopicChatEvents, chatID, outboxProducer, event, at)
+}
+
+// activeMemberIDs lists the user IDs of the chat's active members, inside tx.
+func activeMemberIDs(ctx context.Context, tx *sql.Tx, chatID string) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, `SELECT user_id FROM chat_members WHERE chat_id = $1 AND membership_status = 'active'`, chatID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+func enqueueMessageSent(ctx context.Context, tx *sql.Tx, msg *domain.Message) error {
+	recipients, err := activeMemberIDs(ctx, tx, msg.ChatID.String())
+	if err != nil {
+		return err
+	}
+
+	ev := &pb.MessageSent{
+		ChatId:           msg.ChatID.String(),
+		MessageId:        msg.ID,
+		ContentType:      string(msg.ContentType),
+		Content:          msg.Content,
+		Forwarded:        msg.Forwarded,
+		RecipientUserIds: recipients,
+		CreatedAt:        formatEventTime(msg.CreatedAt),
+	}
+	if msg.SenderID != nil {
+		ev.SenderUserId = msg.SenderID.String()
+	}
+	if msg.MediaURL != nil {
+		ev.MediaUrl = *msg.MediaURL
+	}
+	if msg.ClientMessageID != nil {
+		ev.ClientMessageId = *msg.ClientMessageID
+	}
+	if msg.ReplyToMessageID != nil {
+		ev.ReplyToMessageId = *msg.ReplyToMessageID
+	}
+	if msg.ReplyToStatusID != nil {
+		ev.ReplyToStatusId = msg.ReplyToStatusID.String()
+	}
+	return enqueueChatEvent(ctx, tx, ev.ChatId, ev, msg.CreatedAt)
+}
+
+// enqueueReceipts records one ReceiptUpdated event per chat and sender of updates.
+func enqueueReceipts(ctx context.Context, tx *sql.Tx, readerID string, st domain.MessageStatusType, at time.Time, updates []repository.ReceiptUpdate) error {
+	type key struct{ chat, sender string }
+	batches := map[key]*pb.ReceiptUpdated{}
+	var order []key
+	for _, u := range updates {
+		if u.SenderID == nil {
+			continue // system notifications have no sender to notify
+		}
+		k := key{u.ChatID.String(), u.SenderID.String()}
+		ev, ok := batches[k]
+		if !ok {
+			ev = &pb.ReceiptUpdated{
+				ChatId:       k.chat,
+				SenderUserId: k.sender,
+				ReaderUserId: readerID,
+				Status:       string(st),
+				At:           formatEventTime(at),
+			}
+			batches[k] = ev
+			order = append(order, k)
+		}
+		ev.MessageIds = append(ev.MessageIds, u.MessageID)
+	}
+	for _, k := range order {
+		if err := enqueueChatEvent(ctx, tx, k.chat, batches[k], at); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// enqueueMemberAdded records that userID became an active member of chatID, added by
+// addedBy or on their own when addedBy is empty.
+func enqueueMemberAdded(ctx context.Context, tx *sql.Tx, chatID string, userID string, addedBy string, role domain.ChatMemberRole, at time.Time) error {
+	return enqueueChatEvent(ctx, tx, chatID, &pb.MemberAdded{
+		ChatId:        chatID,
+		UserId:        userID,
+		AddedByUserId: addedBy,
+		Role:          string(role),
+		JoinedAt:      formatEventTime(at),
+	}, at)
+}
+
+// enqueueReactionChanged records that userID set emoji on a message of chatID, or removed
+// their reaction when emoji is empty.
+func enqueueReactionChanged(ctx context.Context, tx *sql.Tx, chatID string, messageID int64, userID string, emoji string, at time.Time) error {
+	recipients, err := activeMemberIDs(ctx, tx, chatID)
+	if err != nil {
+		return err
+	}
+	return enqueueChatEvent(ctx, tx, chatID, &pb.ReactionChanged{
+		ChatId:           chatID,
+		MessageId:        messageID,
+		UserId:           userID,
+		Emoji:            emoji,
+		At:               formatEventTime(at),
+		RecipientUserIds: recipients,
+	}, at)
+}
+
+// enqueuePollUpdated records the poll's tally as tx sees it after userID's change. The
+// caller must hold the poll's row lock so that events follow the order of the changes.
+func enqueuePollUpdated(ctx context.Context, tx *sql.Tx, pollID string, userID string, at time.Time) error {
+	ev := &pb.PollUpdated{PollId: pollID, UserId: userID, At: formatEventTime(at)}
+	q := `SELECT chat_id, closed_at IS NOT NULL FROM polls WHERE id = $1`
+	if err := tx.QueryRowContext(ctx, q, pollID).Scan(&ev.ChatId, &ev.Closed); err != nil {
+		return err
+	}
+
+	cq := `
+	SELECT o.id, COUNT(v.user_id)
+	FROM poll_options o
+	LEFT JOIN poll_votes v ON v.poll_id = o.poll_id AND v.option_id = o.id
+	WHERE o.poll_id = $1
+	GROUP BY o.id
+	ORDER BY o.id
+	`
+	rows, err := tx.QueryContext(ctx, cq, pollID)
+	if err != nil {
+		return err
+	}
+	for rows.Next() {
+		c := &pb.PollOptionTally{}
+		if err := rows.Scan(&c.OptionId, &c.Count); err != nil {
+			rows.Close()
+			return err
+		}
+		ev.Counts = append(ev.Counts, c)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	if err := tx.QueryRowContext(ctx, `SELECT COUNT(DISTINCT user_id) FROM poll_votes WHERE poll_id = $1`, pollID).Scan(&ev.TotalVoters); err != nil {
+		return err
+	}
+	if ev.RecipientUserIds, err = activeMemberIDs(ctx, tx, ev.ChatId); err != nil {
+		return err
+	}
+	return enqueueChatEvent(ctx, tx, ev.ChatId, ev, at)
+}
+
+// formatEventTime renders event timestamps as RFC3339 strings.
+func formatEventTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339Nano)
+}
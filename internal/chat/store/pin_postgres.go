@@ -0,0 +1,105 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/dykethecreator/GoApp/internal/chat/repository"
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/lib/pq"
+)
+
+// PinStore implements PinRepository for PostgreSQL.
+type PinStore struct {
+	db *sql.DB
+}
+
+func NewPinStore(db *sql.DB) repository.PinRepository {
+	return &PinStore{db: db}
+}
+
+func (s *PinStore) Pin(ctx context.Context, pin *domain.PinnedMessage, maxPins int) error {
+	if pin.PinnedAt.IsZero() {
+		pin.PinnedAt = time.Now()
+	}
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	q := `
+	INSERT INTO pinned_messages (chat_id, message_id, pinned_by_user_id, pinned_at, expires_at)
+	VALUES ($1,$2,$3,$4,$5)
+	ON CONFLICT (chat_id, message_id)
+	DO UPDATE SET pinned_by_user_id = EXCLUDED.pinned_by_user_id, pinned_at = EXCLUDED.pinned_at, expires_at = EXCLUDED.expires_at
+	`
+	if _, err := tx.ExecContext(ctx, q, pin.ChatID, pin.MessageID, pin.PinnedByUserID, pin.PinnedAt, pin.ExpiresAt); err != nil {
+		return err
+	}
+	// Drop expired pins, then the oldest ones beyond the limit.
+	if _, err := tx.ExecContext(ctx, `DELETE FROM pinned_messages WHERE chat_id = $1 AND expires_at <= $2`, pin.ChatID, pin.PinnedAt); err != nil {
+		return err
+	}
+	trim := `
+	DELETE FROM pinned_messages
+	WHERE chat_id = $1 AND message_id IN (
+		SELECT message_id FROM pinned_messages WHERE chat_id = $1
+		ORDER BY pinned_at DESC, message_id DESC
+		OFFSET $2
+	)`
+	if _, err := tx.ExecContext(ctx, trim, pin.ChatID, maxPins); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (s *PinStore) Unpin(ctx context.Context, chatID string, messageID int64) (bool, error) {
+	res, err := s.db.ExecContext(ctx, `DELETE FROM pinned_messages WHERE chat_id = $1 AND message_id = $2`, chatID, messageID)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
+
+func (s *PinStore) ListActive(ctx context.Context, chatIDs []string, at time.Time) (map[string][]*repository.ActivePin, error) {
+	out := map[string][]*repository.ActivePin{}
+	if len(chatIDs) == 0 {
+		return out, nil
+	}
+	q := `SELECT p.chat_id, p.message_id, p.pinned_by_user_id, p.pinned_at, p.expires_at, ` + prefixedMessageColumns("m") + `
+	FROM pinned_messages p
+	JOIN messages m ON m.id = p.message_id
+	WHERE p.chat_id = ANY($1::uuid[]) AND p.expires_at > $2 AND m.deleted_at IS NULL
+	ORDER BY p.chat_id, p.pinned_at DESC, p.message_id DESC`
+	rows, err := s.db.QueryContext(ctx, q, pq.Array(chatIDs), at)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var ap repository.ActivePin
+		msg, err := scanMessage(pinScanner{rows, &ap.Pin})
+		if err != nil {
+			return nil, err
+		}
+		ap.Message = msg
+		key := ap.Pin.ChatID.String()
+		out[key] = append(out[key], &ap)
+	}
+	return out, rows.Err()
+}
+
+// pinScanner reads the pin columns that precede the message columns in ListActive.
+type pinScanner struct {
+	rows *sql.Rows
+	pin  *domain.PinnedMessage
+}
+
+func (p pinScanner) Scan(dest ...interface{}) error {
+	head := []interface{}{&p.pin.ChatID, &p.pin.MessageID, &p.pin.PinnedByUserID, &p.pin.PinnedAt, &p.pin.ExpiresAt}
+	return p.rows.Scan(append(head, dest...)...)
+}
@@ -0,0 +1,186 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/dykethecreator/GoApp/internal/chat/repository"
+	"github.com/dykethecreator/GoApp/pkg/domain"
+)
+
+// PollStore implements PollRepository for PostgreSQL.
+type PollStore struct {
+	db *sql.DB
+	// messages inserts poll messages in the transaction that creates the poll.
+	messages *MessageStore
+}
+
+// NewPollStore creates a PollStore. searchConfig is passed on to the message store that
+// posts poll messages, as in NewMessageStore.
+func NewPollStore(db *sql.DB, searchConfig string) repository.PollRepository {
+	return &PollStore{db: db, messages: newMessageStore(db, searchConfig)}
+}
+
+func (s *PollStore) Create(ctx context.Context, poll *domain.Poll, options []*domain.PollOption, msg *domain.Message) (*domain.Message, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	stored, _, err := s.messages.insertMessage(ctx, tx, msg)
+	if err != nil {
+		return nil, err
+	}
+	q := `
+	INSERT INTO polls (id, chat_id, created_by_user_id, question_text, allow_multiple_answers, message_id)
+	VALUES ($1,$2,$3,$4,$5,$6)
+	RETURNING created_at
+	`
+	if err := tx.QueryRowContext(ctx, q, poll.ID, poll.ChatID, poll.CreatedByUserID, poll.QuestionText, poll.AllowMultipleAnswers, stored.ID).
+		Scan(&poll.CreatedAt); err != nil {
+		return nil, err
+	}
+	poll.MessageID = &stored.ID
+	for _, o := range options {
+		o.PollID = poll.ID
+		if err := tx.QueryRowContext(ctx, `INSERT INTO poll_options (poll_id, option_text) VALUES ($1,$2) RETURNING id`,
+			poll.ID, o.OptionText).Scan(&o.ID); err != nil {
+			return nil, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return stored, nil
+}
+
+func (s *PollStore) FindByID(ctx context.Context, pollID string) (*domain.Poll, error) {
+	q := `SELECT id, chat_id, created_by_user_id, question_text, created_at, allow_multiple_answers, closed_at, message_id FROM polls WHERE id = $1`
+	var p domain.Poll
+	if err := s.db.QueryRowContext(ctx, q, pollID).Scan(
+		&p.ID, &p.ChatID, &p.CreatedByUserID, &p.QuestionText, &p.CreatedAt, &p.AllowMultipleAnswers, &p.ClosedAt, &p.MessageID,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &p, nil
+}
+
+func (s *PollStore) ListOptions(ctx context.Context, pollID string) ([]*domain.PollOption, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT id, poll_id, option_text FROM poll_options WHERE poll_id = $1 ORDER BY id`, pollID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []*domain.PollOption
+	for rows.Next() {
+		var o domain.PollOption
+		if err := rows.Scan(&o.ID, &o.PollID, &o.OptionText); err != nil {
+			return nil, err
+		}
+		out = append(out, &o)
+	}
+	return out, rows.Err()
+}
+
+func (s *PollStore) ListVotes(ctx context.Context, pollID string) ([]*domain.PollVote, error) {
+	q := `SELECT poll_id, option_id, user_id, voted_at FROM poll_votes WHERE poll_id = $1 ORDER BY voted_at, user_id`
+	rows, err := s.db.QueryContext(ctx, q, pollID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []*domain.PollVote
+	for rows.Next() {
+		var v domain.PollVote
+		if err := rows.Scan(&v.PollID, &v.OptionID, &v.UserID, &v.VotedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, &v)
+	}
+	return out, rows.Err()
+}
+
+func (s *PollStore) ReplaceVotes(ctx context.Context, pollID string, userID string, optionIDs []int64, at time.Time) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := lockPoll(ctx, tx, pollID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM poll_votes WHERE poll_id = $1 AND user_id = $2`, pollID, userID); err != nil {
+		return err
+	}
+	for _, id := range optionIDs {
+		q := `INSERT INTO poll_votes (poll_id, option_id, user_id, voted_at) VALUES ($1,$2,$3,$4)`
+		if _, err := tx.ExecContext(ctx, q, pollID, id, userID, at); err != nil {
+			return err
+		}
+	}
+	if err := enqueuePollUpdated(ctx, tx, pollID, userID, at); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (s *PollStore) DeleteVotes(ctx context.Context, pollID string, userID string) (bool, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	if err := lockPoll(ctx, tx, pollID); err != nil {
+		return false, err
+	}
+	res, err := tx.ExecContext(ctx, `DELETE FROM poll_votes WHERE poll_id = $1 AND user_id = $2`, pollID, userID)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil || n == 0 {
+		return false, err
+	}
+	if err := enqueuePollUpdated(ctx, tx, pollID, userID, time.Now()); err != nil {
+		return false, err
+	}
+	return true, tx.Commit()
+}
+
+func (s *PollStore) Close(ctx context.Context, pollID string, userID string, at time.Time) (bool, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, `UPDATE polls SET closed_at = $2 WHERE id = $1 AND closed_at IS NULL`, pollID, at)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil || n == 0 {
+		return false, err
+	}
+	if err := enqueuePollUpdated(ctx, tx, pollID, userID, at); err != nil {
+		return false, err
+	}
+	return true, tx.Commit()
+}
+
+// lockPoll takes the poll's row lock, so concurrent changes to its votes, and the tallies
+// they record, are serialized.
+func lockPoll(ctx context.Context, tx *sql.Tx, pollID string) error {
+	_, err := tx.ExecContext(ctx, `SELECT 1 FROM polls WHERE id = $1 FOR UPDATE`, pollID)
+	return err
+}
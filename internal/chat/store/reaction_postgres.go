@@ -0,0 +1,119 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/dykethecreator/GoApp/internal/chat/repository"
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/lib/pq"
+)
+
+// ReactionStore implements ReactionRepository for PostgreSQL.
+type ReactionStore struct {
+	db *sql.DB
+}
+
+func NewReactionStore(db *sql.DB) repository.ReactionRepository {
+	return &ReactionStore{db: db}
+}
+
+func (s *ReactionStore) Upsert(ctx context.Context, reaction *domain.MessageReaction) error {
+	if reaction.CreatedAt.IsZero() {
+		reaction.CreatedAt = time.Now()
+	}
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	q := `
+	INSERT INTO message_reactions (message_id, user_id, reaction_emoji, created_at)
+	VALUES ($1,$2,$3,$4)
+	ON CONFLICT (message_id, user_id)
+	DO UPDATE SET reaction_emoji = EXCLUDED.reaction_emoji, created_at = EXCLUDED.created_at
+	`
+	if _, err := tx.ExecContext(ctx, q, reaction.MessageID, reaction.UserID, reaction.ReactionEmoji, reaction.CreatedAt); err != nil {
+		return err
+	}
+	chatID, err := messageChatID(ctx, tx, reaction.MessageID)
+	if err != nil {
+		return err
+	}
+	if err := enqueueReactionChanged(ctx, tx, chatID, reaction.MessageID, reaction.UserID.String(), reaction.ReactionEmoji, reaction.CreatedAt); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (s *ReactionStore) Delete(ctx context.Context, messageID int64, userID string) (bool, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, `DELETE FROM message_reactions WHERE message_id = $1 AND user_id = $2`, messageID, userID)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil || n == 0 {
+		return false, err
+	}
+	chatID, err := messageChatID(ctx, tx, messageID)
+	if err != nil {
+		return false, err
+	}
+	if err := enqueueReactionChanged(ctx, tx, chatID, messageID, userID, "", time.Now()); err != nil {
+		return false, err
+	}
+	return true, tx.Commit()
+}
+
+// messageChatID returns the chat a message belongs to.
+func messageChatID(ctx context.Context, tx *sql.Tx, messageID int64) (string, error) {
+	var chatID string
+	err := tx.QueryRowContext(ctx, `SELECT chat_id FROM messages WHERE id = $1`, messageID).Scan(&chatID)
+	return chatID, err
+}
+
+func (s *ReactionStore) Aggregate(ctx context.Context, messageIDs []int64, readerID string) (map[int64]*repository.MessageReactions, error) {
+	out := map[int64]*repository.MessageReactions{}
+	if len(messageIDs) == 0 {
+		return out, nil
+	}
+	q := `
+	SELECT message_id, reaction_emoji, COUNT(*), BOOL_OR(user_id = $2)
+	FROM message_reactions
+	WHERE message_id = ANY($1)
+	GROUP BY message_id, reaction_emoji
+	ORDER BY message_id, COUNT(*) DESC, MIN(created_at) ASC
+	`
+	rows, err := s.db.QueryContext(ctx, q, pq.Array(messageIDs), readerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id int64
+		var rc repository.ReactionCount
+		var mine bool
+		if err := rows.Scan(&id, &rc.Emoji, &rc.Count, &mine); err != nil {
+			return nil, err
+		}
+		agg, ok := out[id]
+		if !ok {
+			agg = &repository.MessageReactions{}
+			out[id] = agg
+		}
+		agg.Counts = append(agg.Counts, rc)
+		if mine {
+			agg.MyReaction = rc.Emoji
+		}
+	}
+	return out, rows.Err()
+}
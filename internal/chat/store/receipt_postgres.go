@@ -0,0 +1,190 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/dykethecreator/GoApp/internal/chat/repository"
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/lib/pq"
+)
+
+// ReceiptStore implements ReceiptRepository for PostgreSQL.
+type ReceiptStore struct {
+	db *sql.DB
+}
+
+func NewReceiptStore(db *sql.DB) repository.ReceiptRepository {
+	return &ReceiptStore{db: db}
+}
+
+// unreadCandidate is the condition on messages m that member cm of their chat may have
+// left unread: sent by someone else after cm joined, neither a system notification nor
+// deleted, expired or hidden by cm. Delivering, reading and recounting unread messages
+// share it so they agree on which messages the member can see.
+const unreadCandidate = `m.created_at >= cm.joined_at
+	AND m.sender_id IS DISTINCT FROM cm.user_id
+	AND m.content_type <> 'system_notification'
+	AND m.deleted_at IS NULL
+	AND (m.expires_at IS NULL OR m.expires_at > NOW())
+	AND NOT EXISTS (SELECT 1 FROM message_deletions md WHERE md.message_id = m.id AND md.user_id = cm.user_id)`
+
+func (s *ReceiptStore) MarkDelivered(ctx context.Context, userID string, messageIDs []int64, at time.Time) ([]repository.ReceiptUpdate, error) {
+	if len(messageIDs) == 0 {
+		return nil, nil
+	}
+	q := `
+	WITH targets AS (
+		SELECT m.id, m.chat_id, m.sender_id FROM messages m
+		JOIN chat_members cm ON cm.chat_id = m.chat_id AND cm.user_id = $1 AND cm.membership_status = 'active'
+		WHERE m.id = ANY($2) AND ` + unreadCandidate + `
+	), written AS (
+		INSERT INTO message_statuses (message_id, user_id, status, "timestamp", delivered_at)
+		SELECT id, $1, 'delivered', $3, $3 FROM targets
+		ON CONFLICT (message_id, user_id) DO NOTHING
+		RETURNING message_id
+	), logged AS (
+		` + logReceipts(1, 3, 4) + `
+	)
+	SELECT t.id, t.chat_id, t.sender_id FROM written w JOIN targets t ON t.id = w.message_id
+	ORDER BY t.id
+	`
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, q, userID, pq.Array(messageIDs), at, domain.DeliveredStatus)
+	if err != nil {
+		return nil, err
+	}
+	updates, err := scanReceiptUpdates(rows)
+	if err != nil {
+		return nil, err
+	}
+	if err := enqueueReceipts(ctx, tx, userID, domain.DeliveredStatus, at, updates); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return updates, nil
+}
+
+func (s *ReceiptStore) MarkReadUpTo(ctx context.Context, userID string, chatID string, upToMessageID int64, at time.Time) ([]repository.ReceiptUpdate, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	q := `
+	WITH targets AS (
+		SELECT m.id, m.chat_id, m.sender_id FROM messages m
+		JOIN chat_members cm ON cm.chat_id = m.chat_id AND cm.user_id = $1
+		WHERE m.chat_id = $2 AND m.id <= $3 AND ` + unreadCandidate + `
+		AND NOT EXISTS (
+			SELECT 1 FROM message_statuses ms
+			WHERE ms.message_id = m.id AND ms.user_id = $1 AND ms.read_at IS NOT NULL
+		)
+	), written AS (
+		INSERT INTO message_statuses (message_id, user_id, status, "timestamp", delivered_at, read_at)
+		SELECT id, $1, 'read', $4, $4, $4 FROM targets
+		ON CONFLICT (message_id, user_id) DO UPDATE
+		SET status = 'read', "timestamp" = EXCLUDED."timestamp", read_at = EXCLUDED.read_at,
+			delivered_at = COALESCE(message_statuses.delivered_at, EXCLUDED.delivered_at)
+		RETURNING message_id
+	), logged AS (
+		` + logReceipts(1, 4, 5) + `
+	)
+	SELECT t.id, t.chat_id, t.sender_id FROM written w JOIN targets t ON t.id = w.message_id
+	ORDER BY t.id
+	`
+	rows, err := tx.QueryContext(ctx, q, userID, chatID, upToMessageID, at, domain.ReadStatus)
+	if err != nil {
+		return nil, err
+	}
+	updates, err := scanReceiptUpdates(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	// Recount rather than subtract: the messages just marked may include ones that were
+	// never counted as unread, e.g. history from before the reader joined. Everything up
+	// to upToMessageID is read now, so only later messages can still be unread.
+	uq := `UPDATE chat_members cm SET marked_unread = FALSE, unread_count = (
+		SELECT COUNT(*) FROM messages m
+		WHERE m.chat_id = cm.chat_id AND m.id > $3 AND ` + unreadCandidate + `
+		AND NOT EXISTS (
+			SELECT 1 FROM message_statuses ms
+			WHERE ms.message_id = m.id AND ms.user_id = cm.user_id AND ms.read_at IS NOT NULL
+		)
+	)
+	WHERE cm.chat_id = $1 AND cm.user_id = $2`
+	if _, err := tx.ExecContext(ctx, uq, chatID, userID, upToMessageID); err != nil {
+		return nil, err
+	}
+	if err := enqueueReceipts(ctx, tx, userID, domain.ReadStatus, at, updates); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return updates, nil
+}
+
+func (s *ReceiptStore) ListForMessage(ctx context.Context, messageID int64) ([]*domain.MessageStatus, error) {
+	q := `
+	SELECT cm.user_id, COALESCE(ms.status, ''), ms."timestamp", ms.delivered_at, ms.read_at
+	FROM messages m
+	JOIN chat_members cm ON cm.chat_id = m.chat_id AND cm.membership_status = 'active'
+	LEFT JOIN message_statuses ms ON ms.message_id = m.id AND ms.user_id = cm.user_id
+	WHERE m.id = $1 AND cm.user_id IS DISTINCT FROM m.sender_id
+	ORDER BY ms.read_at ASC NULLS LAST, ms.delivered_at ASC NULLS LAST, cm.joined_at ASC
+	`
+	rows, err := s.db.QueryContext(ctx, q, messageID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []*domain.MessageStatus
+	for rows.Next() {
+		st := domain.MessageStatus{MessageID: messageID}
+		var ts sql.NullTime
+		if err := rows.Scan(&st.UserID, &st.Status, &ts, &st.DeliveredAt, &st.ReadAt); err != nil {
+			return nil, err
+		}
+		st.Timestamp = ts.Time
+		out = append(out, &st)
+	}
+	return out, rows.Err()
+}
+
+// logReceipts returns a statement, for a CTE after targets and written, that appends a
+// receipt sync event for the sender of each message in written. The arguments are the
+// numbers of the query parameters holding the reader, the time and the status.
+func logReceipts(reader, at, status int) string {
+	return fmt.Sprintf(`INSERT INTO sync_events (chat_id, user_id, kind, message_id, payload)
+		SELECT t.chat_id, t.sender_id, 'receipt', t.id,
+			jsonb_build_object('reader_user_id', $%d::uuid, 'status', $%d::text, 'at', $%d::timestamptz)
+		FROM written w JOIN targets t ON t.id = w.message_id
+		WHERE t.sender_id IS NOT NULL`, reader, status, at)
+}
+
+func scanReceiptUpdates(rows *sql.Rows) ([]repository.ReceiptUpdate, error) {
+	defer rows.Close()
+	var out []repository.ReceiptUpdate
+	for rows.Next() {
+		var u repository.ReceiptUpdate
+		if err := rows.Scan(&u.MessageID, &u.ChatID, &u.SenderID); err != nil {
+			return nil, err
+		}
+		out = append(out, u)
+	}
+	return out, rows.Err()
+}
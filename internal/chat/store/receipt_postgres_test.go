@@ -0,0 +1,174 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	_ "github.com/lib/pq"
+)
+
+// testDB opens the migrated database named by TEST_DATABASE_URL; without it the test is
+// skipped.
+func testDB(t *testing.T) *sql.DB {
+	t.Helper()
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL not set")
+	}
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+// testChat is a group whose members and messages are removed when the test ends.
+type testChat struct {
+	t     *testing.T
+	db    *sql.DB
+	id    string
+	users []string
+}
+
+func newTestChat(t *testing.T, db *sql.DB) *testChat {
+	t.Helper()
+	c := &testChat{t: t, db: db, id: uuid.NewString()}
+	creator := c.newUser()
+	if _, err := db.Exec(`INSERT INTO chats (id, type, created_by_user_id) VALUES ($1, 'group', $2)`, c.id, creator); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		for _, q := range []string{
+			`DELETE FROM outbox_events WHERE aggregate_id = $1`,
+			`DELETE FROM sync_events WHERE chat_id = $1`,
+			`DELETE FROM poll_options WHERE poll_id IN (SELECT id FROM polls WHERE chat_id = $1)`,
+			`DELETE FROM polls WHERE chat_id = $1`,
+			`DELETE FROM message_statuses WHERE message_id IN (SELECT id FROM messages WHERE chat_id = $1)`,
+			`DELETE FROM message_deletions WHERE message_id IN (SELECT id FROM messages WHERE chat_id = $1)`,
+			`DELETE FROM messages WHERE chat_id = $1`,
+			`DELETE FROM chat_members WHERE chat_id = $1`,
+			`DELETE FROM chats WHERE id = $1`,
+		} {
+			db.Exec(q, c.id)
+		}
+		for _, u := range c.users {
+			db.Exec(`DELETE FROM users WHERE id = $1`, u)
+		}
+	})
+	return c
+}
+
+func (c *testChat) newUser() string {
+	c.t.Helper()
+	id := uuid.NewString()
+	phone := fmt.Sprintf("+1%010d", rand.Int63n(1e10))
+	if _, err := c.db.Exec(`INSERT INTO users (id, phone_number) VALUES ($1, $2)`, id, phone); err != nil {
+		c.t.Fatal(err)
+	}
+	c.users = append(c.users, id)
+	return id
+}
+
+// join adds a new active member who joined at joinedAt.
+func (c *testChat) join(joinedAt time.Time) string {
+	c.t.Helper()
+	id := c.newUser()
+	q := `INSERT INTO chat_members (chat_id, user_id, role, membership_status, joined_at) VALUES ($1, $2, 'member', 'active', $3)`
+	if _, err := c.db.Exec(q, c.id, id, joinedAt); err != nil {
+		c.t.Fatal(err)
+	}
+	return id
+}
+
+// post inserts a text message from senderID created at createdAt that expires at expiresAt
+// unless it is nil.
+func (c *testChat) post(senderID string, createdAt time.Time, expiresAt *time.Time) int64 {
+	c.t.Helper()
+	var id int64
+	q := `INSERT INTO messages (chat_id, sender_id, content_type, content, created_at, expires_at)
+	VALUES ($1, $2, 'text', 'hi', $3, $4) RETURNING id`
+	if err := c.db.QueryRow(q, c.id, senderID, createdAt, expiresAt).Scan(&id); err != nil {
+		c.t.Fatal(err)
+	}
+	return id
+}
+
+func (c *testChat) unreadCount(userID string) int {
+	c.t.Helper()
+	var n int
+	if err := c.db.QueryRow(`SELECT unread_count FROM chat_members WHERE chat_id = $1 AND user_id = $2`, c.id, userID).Scan(&n); err != nil {
+		c.t.Fatal(err)
+	}
+	return n
+}
+
+func TestMarkDeliveredSkipsInvisibleMessages(t *testing.T) {
+	db := testDB(t)
+	ctx := context.Background()
+	c := newTestChat(t, db)
+	now := time.Now()
+	sender := c.join(now.Add(-time.Hour))
+	reader := c.join(now.Add(-time.Minute))
+
+	before := c.post(sender, now.Add(-30*time.Minute), nil)
+	past := now.Add(-time.Second)
+	expired := c.post(sender, now.Add(-30*time.Second), &past)
+	hidden := c.post(sender, now.Add(-20*time.Second), nil)
+	own := c.post(reader, now.Add(-15*time.Second), nil)
+	visible := c.post(sender, now.Add(-10*time.Second), nil)
+	if _, err := db.Exec(`INSERT INTO message_deletions (message_id, user_id) VALUES ($1, $2)`, hidden, reader); err != nil {
+		t.Fatal(err)
+	}
+
+	s := NewReceiptStore(db)
+	updates, err := s.MarkDelivered(ctx, reader, []int64{before, expired, hidden, own, visible}, now)
+	if err != nil {
+		t.Fatalf("MarkDelivered: %v", err)
+	}
+	if len(updates) != 1 || updates[0].MessageID != visible {
+		t.Fatalf("updates = %+v, want only message %d", updates, visible)
+	}
+}
+
+func TestMarkReadUpToRecountsUnread(t *testing.T) {
+	db := testDB(t)
+	ctx := context.Background()
+	c := newTestChat(t, db)
+	now := time.Now()
+	sender := c.join(now.Add(-time.Hour))
+	reader := c.join(now.Add(-time.Minute))
+
+	// History from before the reader joined was never counted as unread.
+	c.post(sender, now.Add(-30*time.Minute), nil)
+	first := c.post(sender, now.Add(-30*time.Second), nil)
+	c.post(sender, now.Add(-20*time.Second), nil)
+	c.post(sender, now.Add(-10*time.Second), nil)
+	if _, err := db.Exec(`UPDATE chat_members SET unread_count = 3, marked_unread = TRUE WHERE chat_id = $1 AND user_id = $2`, c.id, reader); err != nil {
+		t.Fatal(err)
+	}
+
+	updates, err := NewReceiptStore(db).MarkReadUpTo(ctx, reader, c.id, first, now)
+	if err != nil {
+		t.Fatalf("MarkReadUpTo: %v", err)
+	}
+	if len(updates) != 1 || updates[0].MessageID != first {
+		t.Fatalf("updates = %+v, want only message %d", updates, first)
+	}
+	if got := c.unreadCount(reader); got != 2 {
+		t.Errorf("unread_count = %d, want 2", got)
+	}
+	var marked bool
+	if err := db.QueryRow(`SELECT marked_unread FROM chat_members WHERE chat_id = $1 AND user_id = $2`, c.id, reader).Scan(&marked); err != nil {
+		t.Fatal(err)
+	}
+	if marked {
+		t.Error("marked_unread is still set")
+	}
+}
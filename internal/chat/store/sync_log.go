@@ -0,0 +1,26 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/dykethecreator/GoApp/pkg/domain"
+)
+
+// logSyncEvent appends a change to sync_events inside the transaction that made it, so
+// devices catching up see every change exactly once and in commit order. A nil userID
+// makes the event visible to every member of the chat.
+func logSyncEvent(ctx context.Context, tx *sql.Tx, chatID interface{}, userID interface{}, kind domain.SyncEventKind, messageID int64, payload interface{}) error {
+	var data interface{}
+	if payload != nil {
+		b, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+		data = b
+	}
+	q := `INSERT INTO sync_events (chat_id, user_id, kind, message_id, payload) VALUES ($1, $2, $3, $4, $5)`
+	_, err := tx.ExecContext(ctx, q, chatID, userID, kind, messageID, data)
+	return err
+}
@@ -0,0 +1,56 @@
+// Package outbox implements the transactional outbox: services record domain events in
+// the database transaction that makes the change, and a relay publishes them to the
+// event bus afterwards. An event is published at least once; consumers that must not
+// apply one twice drop duplicates by the envelope's event ID.
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/dykethecreator/GoApp/pkg/eventbus"
+	pb "github.com/dykethecreator/GoApp/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+// Event is an outbox entry waiting to be relayed.
+type Event struct {
+	ID       int64
+	Topic    string
+	Key      string // the aggregate, e.g. a chat ID
+	Envelope *pb.EventEnvelope
+}
+
+// Repository stores outbox entries.
+type Repository interface {
+	// Relay passes up to limit unsent events to publish in one call, in order, marks them
+	// sent once publish succeeded and returns how many there were. When publish fails the
+	// whole batch stays unsent and is passed again next time. Events whose envelope cannot
+	// be decoded are marked failed and skipped. Only one relay runs at a time across
+	// processes; others send nothing.
+	Relay(ctx context.Context, limit int, publish func(ctx context.Context, events []Event) error) (int, error)
+	// Prune deletes up to limit events sent before before and returns how many it deleted.
+	// Failed events are kept.
+	Prune(ctx context.Context, before time.Time, limit int) (int, error)
+}
+
+// Enqueue records env for publishing on topic under key once tx commits.
+func Enqueue(ctx context.Context, tx *sql.Tx, topic string, key string, env *pb.EventEnvelope) error {
+	data, err := proto.Marshal(env)
+	if err != nil {
+		return err
+	}
+	q := `INSERT INTO outbox_events (event_id, topic, aggregate_id, event_type, envelope) VALUES ($1, $2, $3, $4, $5)`
+	_, err = tx.ExecContext(ctx, q, env.GetEventId(), topic, key, env.GetType(), data)
+	return err
+}
+
+// EnqueueEvent wraps event in a new envelope from producer and enqueues it.
+func EnqueueEvent(ctx context.Context, tx *sql.Tx, topic string, key string, producer string, event proto.Message, occurredAt time.Time) error {
+	env, err := eventbus.NewEnvelope(producer, event, occurredAt)
+	if err != nil {
+		return err
+	}
+	return Enqueue(ctx, tx, topic, key, env)
+}
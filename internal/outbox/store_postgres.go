@@ -0,0 +1,107 @@
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"time"
+
+	pb "github.com/dykethecreator/GoApp/proto"
+	"github.com/lib/pq"
+	"google.golang.org/protobuf/proto"
+)
+
+// Store implements Repository for PostgreSQL.
+type Store struct {
+	db *sql.DB
+}
+
+func NewStore(db *sql.DB) Repository {
+	return &Store{db: db}
+}
+
+func (s *Store) Relay(ctx context.Context, limit int, publish func(ctx context.Context, events []Event) error) (int, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	// A single relay keeps events in order; the lock lasts until this batch is done.
+	var locked bool
+	if err := tx.QueryRowContext(ctx, `SELECT pg_try_advisory_xact_lock(hashtext('outbox_relay'))`).Scan(&locked); err != nil {
+		return 0, err
+	}
+	if !locked {
+		return 0, nil
+	}
+
+	q := `SELECT id, topic, aggregate_id, envelope FROM outbox_events
+	WHERE sent_at IS NULL AND failed_at IS NULL AND txid < pg_snapshot_xmin(pg_current_snapshot())
+	ORDER BY txid, id
+	LIMIT $1`
+	rows, err := tx.QueryContext(ctx, q, limit)
+	if err != nil {
+		return 0, err
+	}
+	var events []Event
+	failed := map[int64]error{}
+	for rows.Next() {
+		var ev Event
+		var data []byte
+		if err := rows.Scan(&ev.ID, &ev.Topic, &ev.Key, &data); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		ev.Envelope = &pb.EventEnvelope{}
+		if err := proto.Unmarshal(data, ev.Envelope); err != nil {
+			failed[ev.ID] = err
+			continue
+		}
+		events = append(events, ev)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	for id, decodeErr := range failed {
+		log.Printf("Warning: skipping outbox event %d with an undecodable envelope: %v", id, decodeErr)
+		q := `UPDATE outbox_events SET failed_at = NOW(), last_error = $2 WHERE id = $1`
+		if _, err := tx.ExecContext(ctx, q, id, decodeErr.Error()); err != nil {
+			return 0, err
+		}
+	}
+
+	var sent []int64
+	var publishErr error
+	if len(events) > 0 {
+		if publishErr = publish(ctx, events); publishErr == nil {
+			for _, ev := range events {
+				sent = append(sent, ev.ID)
+			}
+		}
+	}
+	if len(sent) > 0 {
+		if _, err := tx.ExecContext(ctx, `UPDATE outbox_events SET sent_at = NOW() WHERE id = ANY($1)`, pq.Array(sent)); err != nil {
+			return 0, err
+		}
+	}
+	if len(sent) > 0 || len(failed) > 0 {
+		if err := tx.Commit(); err != nil {
+			return 0, err
+		}
+	}
+	return len(sent), publishErr
+}
+
+func (s *Store) Prune(ctx context.Context, before time.Time, limit int) (int, error) {
+	q := `DELETE FROM outbox_events WHERE id IN (
+		SELECT id FROM outbox_events WHERE sent_at < $1 ORDER BY sent_at LIMIT $2)`
+	res, err := s.db.ExecContext(ctx, q, before, limit)
+	if err != nil {
+		return 0, err
+	}
+	n, err := res.RowsAffected()
+	return int(n), err
+}
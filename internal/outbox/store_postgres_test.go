@@ -0,0 +1,186 @@
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/dykethecreator/GoApp/pkg/eventbus"
+	pb "github.com/dykethecreator/GoApp/proto"
+	_ "github.com/lib/pq"
+	"google.golang.org/protobuf/proto"
+)
+
+// testDB opens the migrated database named by TEST_DATABASE_URL and empties the outbox.
+// Without it the test is skipped.
+func testDB(t *testing.T) *sql.DB {
+	t.Helper()
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL not set")
+	}
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	if _, err := db.Exec(`DELETE FROM outbox_events`); err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+// enqueue records event in its own committed transaction.
+func enqueue(t *testing.T, db *sql.DB, key string, event proto.Message) {
+	t.Helper()
+	ctx := context.Background()
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tx.Rollback()
+	if err := EnqueueEvent(ctx, tx, eventbus.TopicChatEvents, key, "test", event, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// relayAll relays until want events were published or a few seconds pass; transactions
+// still running elsewhere in the database may hold events back for a moment.
+func relayAll(t *testing.T, store Repository, want int, publish func(context.Context, []Event) error) int {
+	t.Helper()
+	total := 0
+	deadline := time.Now().Add(5 * time.Second)
+	for total < want && time.Now().Before(deadline) {
+		n, err := store.Relay(context.Background(), 100, publish)
+		total += n
+		if err != nil {
+			t.Fatalf("Relay: %v", err)
+		}
+		if total < want {
+			time.Sleep(50 * time.Millisecond)
+		}
+	}
+	return total
+}
+
+func TestRelayPublishesInOrderAndSkipsUndecodableRows(t *testing.T) {
+	db := testDB(t)
+	store := NewStore(db)
+
+	enqueue(t, db, "chat-1", &pb.MessageSent{ChatId: "chat-1", MessageId: 1})
+	q := `INSERT INTO outbox_events (event_id, topic, aggregate_id, event_type, envelope)
+	VALUES (gen_random_uuid(), $1, 'chat-1', 'events.MessageSent', '\xffff')`
+	if _, err := db.Exec(q, eventbus.TopicChatEvents); err != nil {
+		t.Fatal(err)
+	}
+	enqueue(t, db, "chat-1", &pb.MessageSent{ChatId: "chat-1", MessageId: 2})
+	enqueue(t, db, "chat-2", &pb.MessageSent{ChatId: "chat-2", MessageId: 3})
+
+	var got []int64
+	publish := func(_ context.Context, events []Event) error {
+		for _, ev := range events {
+			e, err := eventbus.DecodeEvent(ev.Envelope)
+			if err != nil {
+				return err
+			}
+			got = append(got, e.(*pb.MessageSent).MessageId)
+		}
+		return nil
+	}
+	if n := relayAll(t, store, 3, publish); n != 3 {
+		t.Fatalf("relayed %d events, want 3", n)
+	}
+	if len(got) != 3 || got[0] != 1 || got[1] != 2 || got[2] != 3 {
+		t.Errorf("published %v, want [1 2 3]", got)
+	}
+
+	var failed int
+	var lastError sql.NullString
+	if err := db.QueryRow(`SELECT COUNT(*), MAX(last_error) FROM outbox_events WHERE failed_at IS NOT NULL AND sent_at IS NULL`).Scan(&failed, &lastError); err != nil {
+		t.Fatal(err)
+	}
+	if failed != 1 || lastError.String == "" {
+		t.Errorf("%d failed rows with error %q, want 1 with an error", failed, lastError.String)
+	}
+
+	if n, err := store.Relay(context.Background(), 100, publish); err != nil || n != 0 {
+		t.Errorf("second Relay = %d, %v; want nothing left", n, err)
+	}
+}
+
+func TestRelayKeepsBatchOnPublishErrorAndResumes(t *testing.T) {
+	db := testDB(t)
+	store := NewStore(db)
+	for i := int64(1); i <= 3; i++ {
+		enqueue(t, db, "chat-1", &pb.MessageSent{ChatId: "chat-1", MessageId: i})
+	}
+
+	broken := errors.New("broker down")
+	var got []int64
+	calls := 0
+	publish := func(_ context.Context, events []Event) error {
+		calls++
+		if calls == 1 {
+			return broken
+		}
+		for _, ev := range events {
+			e, _ := eventbus.DecodeEvent(ev.Envelope)
+			got = append(got, e.(*pb.MessageSent).MessageId)
+		}
+		return nil
+	}
+
+	sent := 0
+	var err error
+	deadline := time.Now().Add(5 * time.Second)
+	for calls < 1 && time.Now().Before(deadline) {
+		var n int
+		n, err = store.Relay(context.Background(), 100, publish)
+		sent += n
+		if err != nil {
+			break
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	if sent != 0 || !errors.Is(err, broken) {
+		t.Fatalf("relayed %d events, err %v; want 0, %v", sent, err, broken)
+	}
+	if total := relayAll(t, store, 3, publish); total != 3 {
+		t.Fatalf("relayed %d events after the error, want 3", total)
+	}
+	if len(got) != 3 || got[0] != 1 || got[1] != 2 || got[2] != 3 {
+		t.Errorf("published %v, want [1 2 3] with nothing lost or reordered", got)
+	}
+}
+
+func TestPruneKeepsUnsentAndFailedRows(t *testing.T) {
+	db := testDB(t)
+	store := NewStore(db)
+	enqueue(t, db, "chat-1", &pb.MessageSent{ChatId: "chat-1", MessageId: 1})
+	enqueue(t, db, "chat-1", &pb.MessageSent{ChatId: "chat-1", MessageId: 2})
+	enqueue(t, db, "chat-1", &pb.MessageSent{ChatId: "chat-1", MessageId: 3})
+	if _, err := db.Exec(`UPDATE outbox_events SET sent_at = NOW() - INTERVAL '2 hours' WHERE id = (SELECT MIN(id) FROM outbox_events)`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(`UPDATE outbox_events SET failed_at = NOW() - INTERVAL '2 hours', last_error = 'bad' WHERE id = (SELECT MAX(id) FROM outbox_events)`); err != nil {
+		t.Fatal(err)
+	}
+
+	n, err := store.Prune(context.Background(), time.Now().Add(-time.Hour), 100)
+	if err != nil || n != 1 {
+		t.Fatalf("Prune = %d, %v; want 1", n, err)
+	}
+	var left int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM outbox_events`).Scan(&left); err != nil {
+		t.Fatal(err)
+	}
+	if left != 2 {
+		t.Errorf("%d rows left, want the unsent and the failed one", left)
+	}
+}
@@ -0,0 +1,175 @@
+package realtime
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+)
+
+// Client is one device's WebSocket connection. A user may have several at once.
+type Client struct {
+	hub      *Hub
+	conn     *websocket.Conn
+	id       string // unique per connection, for the cluster registry
+	userID   string
+	deviceID string
+	// ephemeral is set when the client named no device; its ID lasts one connection.
+	ephemeral bool
+
+	// send queues frames for writePump. It is bounded; a client that falls behind is
+	// disconnected rather than allowed to hold up everyone else.
+	send chan []byte
+
+	// watching lists the users whose presence this connection follows; guarded by the
+	// hub's presenceMu.
+	watching []string
+
+	// expiry closes the connection when its access token expires; nil without one.
+	expiry *time.Timer
+
+	mu     sync.Mutex
+	closed bool
+	// closeCode is the close status sent to the client when the queue is shut.
+	closeCode int
+	closeText string
+}
+
+func newClient(hub *Hub, conn *websocket.Conn, userID string, deviceID string) *Client {
+	c := &Client{
+		hub:       hub,
+		conn:      conn,
+		id:        uuid.NewString(),
+		userID:    userID,
+		deviceID:  deviceID,
+		send:      make(chan []byte, hub.cfg.SendQueueSize),
+		closeCode: websocket.CloseNormalClosure,
+	}
+	if deviceID == "" {
+		c.deviceID, c.ephemeral = uuid.NewString(), true
+	}
+	return c
+}
+
+// UserID returns the authenticated user the connection belongs to.
+func (c *Client) UserID() string { return c.userID }
+
+// DeviceID returns the device the connection was opened from.
+func (c *Client) DeviceID() string { return c.deviceID }
+
+// enqueue queues a frame without blocking. When the queue is full the client is
+// disconnected as a slow consumer and false is returned.
+func (c *Client) enqueue(frame []byte) bool {
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return false
+	}
+	select {
+	case c.send <- frame:
+		c.mu.Unlock()
+		return true
+	default:
+	}
+	c.shutLocked(websocket.CloseTryAgainLater, "slow consumer")
+	c.mu.Unlock()
+
+	log.Printf("realtime: disconnecting slow consumer user=%s device=%s", c.userID, c.deviceID)
+	c.hub.unregister(c)
+	return false
+}
+
+// expireAt disconnects the client at t, when the access token it connected with expires.
+// It must be called before the pumps start.
+func (c *Client) expireAt(t time.Time) {
+	c.expiry = time.AfterFunc(time.Until(t), func() {
+		c.disconnect(websocket.ClosePolicyViolation, "access token expired")
+	})
+}
+
+// disconnect closes the connection with the given status and forgets it right away.
+func (c *Client) disconnect(code int, text string) {
+	c.shut(code, text)
+	c.hub.unregister(c)
+}
+
+// shut closes the send queue; writePump then says goodbye and closes the connection.
+func (c *Client) shut(code int, text string) {
+	c.mu.Lock()
+	c.shutLocked(code, text)
+	c.mu.Unlock()
+}
+
+func (c *Client) shutLocked(code int, text string) {
+	if c.closed {
+		return
+	}
+	c.closed = true
+	c.closeCode, c.closeText = code, text
+	close(c.send)
+}
+
+// readPump handles frames from the client until the connection fails or goes silent
+// for longer than PongWait.
+func (c *Client) readPump() {
+	defer func() {
+		if c.expiry != nil {
+			c.expiry.Stop()
+		}
+		c.hub.unregister(c)
+		c.shut(websocket.CloseNormalClosure, "")
+		c.conn.Close()
+	}()
+
+	c.conn.SetReadLimit(c.hub.cfg.MaxFrameSize)
+	c.conn.SetReadDeadline(time.Now().Add(c.hub.cfg.PongWait))
+	c.conn.SetPongHandler(func(string) error {
+		return c.conn.SetReadDeadline(time.Now().Add(c.hub.cfg.PongWait))
+	})
+	for {
+		kind, data, err := c.conn.ReadMessage()
+		if err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				log.Printf("realtime: read from user=%s device=%s: %v", c.userID, c.deviceID, err)
+			}
+			return
+		}
+		if kind != websocket.TextMessage {
+			continue
+		}
+		c.hub.handleClientFrame(c, data)
+	}
+}
+
+// writePump sends queued frames and pings. It is the only goroutine writing to conn.
+func (c *Client) writePump() {
+	ticker := time.NewTicker(c.hub.cfg.PingPeriod)
+	defer func() {
+		ticker.Stop()
+		c.conn.Close()
+	}()
+
+	for {
+		select {
+		case frame, ok := <-c.send:
+			c.conn.SetWriteDeadline(time.Now().Add(c.hub.cfg.WriteWait))
+			if !ok {
+				c.mu.Lock()
+				msg := websocket.FormatCloseMessage(c.closeCode, c.closeText)
+				c.mu.Unlock()
+				c.conn.WriteMessage(websocket.CloseMessage, msg)
+				return
+			}
+			if err := c.conn.WriteMessage(websocket.TextMessage, frame); err != nil {
+				return
+			}
+		case <-ticker.C:
+			c.conn.SetWriteDeadline(time.Now().Add(c.hub.cfg.WriteWait))
+			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		}
+	}
+}
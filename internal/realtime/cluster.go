@@ -0,0 +1,200 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/dykethecreator/GoApp/internal/realtime/repository"
+	"github.com/dykethecreator/GoApp/pkg/eventbus"
+)
+
+// Cluster links the hubs of several realtime nodes. It records every connection in a
+// shared registry, routes frames for users connected elsewhere to their nodes over the
+// event bus, and spreads presence changes to all nodes.
+type Cluster struct {
+	nodeID    string
+	hub       *Hub
+	conns     repository.ConnectionRepository
+	publisher eventbus.Publisher
+}
+
+// Topics realtime nodes use among themselves.
+const (
+	// TopicPresence carries presence changes between realtime nodes. Every node must
+	// receive every event.
+	TopicPresence = "realtime.presence"
+	// nodeTopicPrefix prefixes the topic each realtime node reads its deliveries from.
+	nodeTopicPrefix = "realtime.node."
+)
+
+// NodeTopic returns the topic realtime node nodeID reads deliveries from.
+func NodeTopic(nodeID string) string {
+	return nodeTopicPrefix + nodeID
+}
+
+// presenceEvent tells realtime nodes that a user's first device connected or their last
+// one disconnected, anywhere in the cluster.
+type presenceEvent struct {
+	UserID    string    `json:"user_id"`
+	Online    bool      `json:"online"`
+	Timestamp time.Time `json:"timestamp"` // when the user went offline, for last seen
+}
+
+// delivery is a frame routed to the users another node serves.
+type delivery struct {
+	UserIDs []string        `json:"user_ids"`
+	Frame   json.RawMessage `json:"frame"`
+}
+
+// NewCluster joins hub to the cluster as nodeID. It must be called before the hub serves
+// any connection. sub must hand this node every event of its own node topic and of
+// TopicPresence, so its consumer group must be the node's alone.
+func NewCluster(nodeID string, hub *Hub, conns repository.ConnectionRepository, pub eventbus.Publisher, sub eventbus.Subscriber) (*Cluster, error) {
+	c := &Cluster{nodeID: nodeID, hub: hub, conns: conns, publisher: pub}
+	err := sub.Subscribe(NodeTopic(nodeID), func(_ context.Context, msg eventbus.Message) error {
+		var d delivery
+		if err := json.Unmarshal(msg.Value, &d); err != nil {
+			log.Printf("realtime: drop malformed delivery: %v", err)
+			return nil
+		}
+		hub.sendLocal(d.UserIDs, d.Frame)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = sub.Subscribe(TopicPresence, func(_ context.Context, msg eventbus.Message) error {
+		var ev presenceEvent
+		if err := json.Unmarshal(msg.Value, &ev); err != nil {
+			log.Printf("realtime: drop malformed presence event: %v", err)
+			return nil
+		}
+		hub.notifyPresence(ev.UserID, ev.Online, ev.Timestamp)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	hub.cluster = c
+	return c, nil
+}
+
+// NodeID returns the name this node is registered under.
+func (c *Cluster) NodeID() string { return c.nodeID }
+
+// Run heartbeats the node's connections in the registry every interval until ctx is done.
+func (c *Cluster) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			hctx, cancel := context.WithTimeout(ctx, c.hub.cfg.LookupTimeout)
+			if err := c.conns.Heartbeat(hctx, c.nodeID); err != nil {
+				log.Printf("realtime: heartbeat of node %s: %v", c.nodeID, err)
+			}
+			cancel()
+		}
+	}
+}
+
+// add registers a local connection and reports whether it is the user's first anywhere.
+func (c *Cluster) add(cl *Client, localFirst bool) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), c.hub.cfg.LookupTimeout)
+	defer cancel()
+	n, err := c.conns.Add(ctx, c.connection(cl))
+	if err != nil {
+		log.Printf("realtime: register connection of user %s: %v", cl.userID, err)
+		return localFirst
+	}
+	return n == 1
+}
+
+// remove unregisters a local connection and reports whether it was the user's last anywhere.
+func (c *Cluster) remove(cl *Client, localLast bool) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), c.hub.cfg.LookupTimeout)
+	defer cancel()
+	n, err := c.conns.Remove(ctx, c.connection(cl))
+	if err != nil {
+		log.Printf("realtime: unregister connection of user %s: %v", cl.userID, err)
+		return localLast
+	}
+	return n == 0
+}
+
+func (c *Cluster) connection(cl *Client) repository.Connection {
+	return repository.Connection{ID: cl.id, UserID: cl.userID, DeviceID: cl.deviceID, NodeID: c.nodeID}
+}
+
+// isOnline reports whether userID has a connection on any node.
+func (c *Cluster) isOnline(userID string) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), c.hub.cfg.LookupTimeout)
+	defer cancel()
+	n, err := c.conns.Count(ctx, userID)
+	if err != nil {
+		log.Printf("realtime: count connections of user %s: %v", userID, err)
+		return c.hub.ConnectionCount(userID) > 0
+	}
+	return n > 0
+}
+
+// onlineUsers reports which of userIDs have a connection on any node. When the registry
+// cannot be read, only this node's connections count.
+func (c *Cluster) onlineUsers(userIDs []string) map[string]bool {
+	ctx, cancel := context.WithTimeout(context.Background(), c.hub.cfg.LookupTimeout)
+	defer cancel()
+	nodes, err := c.conns.Nodes(ctx, userIDs)
+	if err != nil {
+		log.Printf("realtime: look up nodes of %d users: %v", len(userIDs), err)
+		return c.hub.localOnlineUsers(userIDs)
+	}
+	out := make(map[string]bool, len(nodes))
+	for userID, ns := range nodes {
+		if len(ns) > 0 {
+			out[userID] = true
+		}
+	}
+	return out
+}
+
+// route delivers frame to userIDs on whichever nodes serve them. When the registry cannot
+// be read, only local connections get the frame.
+func (c *Cluster) route(userIDs []string, frame []byte) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.hub.cfg.LookupTimeout)
+	defer cancel()
+	nodes, err := c.conns.Nodes(ctx, userIDs)
+	if err != nil {
+		log.Printf("realtime: look up nodes of %d users: %v", len(userIDs), err)
+		c.hub.sendLocal(userIDs, frame)
+		return
+	}
+	byNode := map[string][]string{}
+	for _, userID := range userIDs {
+		for _, node := range nodes[userID] {
+			byNode[node] = append(byNode[node], userID)
+		}
+	}
+	for node, users := range byNode {
+		if node == c.nodeID {
+			c.hub.sendLocal(users, frame)
+			continue
+		}
+		if err := eventbus.PublishJSON(ctx, c.publisher, NodeTopic(node), node, delivery{UserIDs: users, Frame: frame}); err != nil {
+			log.Printf("realtime: route frame to node %s: %v", node, err)
+		}
+	}
+}
+
+// publishPresence tells every node that userID came online or went offline.
+func (c *Cluster) publishPresence(userID string, online bool, at time.Time) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.hub.cfg.LookupTimeout)
+	defer cancel()
+	ev := presenceEvent{UserID: userID, Online: online, Timestamp: at}
+	if err := eventbus.PublishJSON(ctx, c.publisher, TopicPresence, userID, ev); err != nil {
+		log.Printf("realtime: publish presence of user %s: %v", userID, err)
+	}
+}
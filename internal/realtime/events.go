@@ -0,0 +1,121 @@
+package realtime
+
+import (
+	"context"
+	"log"
+
+	"github.com/dykethecreator/GoApp/pkg/eventbus"
+	pb "github.com/dykethecreator/GoApp/proto"
+	"github.com/gorilla/websocket"
+)
+
+// consumedEventIDs is how many recently consumed event IDs a node remembers in order to
+// drop events the outbox relay or the bus delivered twice.
+const consumedEventIDs = 10000
+
+// Consume subscribes the hub to the chat domain events and pushes each one to the
+// recipients' devices connected to this node. It also follows the user events so that a
+// revoked device's connections are closed. Every node needs every event, so sub's consumer
+// group must be the node's alone; since each node serves its own devices, events are never
+// routed on to other nodes. Events seen before are skipped, so devices don't get the same
+// frame twice.
+func (h *Hub) Consume(sub eventbus.Subscriber) error {
+	users := eventbus.NewEventMux()
+	eventbus.HandleEvent(users, func(_ context.Context, _ *pb.EventEnvelope, ev *pb.DeviceRevoked) error {
+		h.DisconnectDevice(ev.UserId, ev.DeviceId, websocket.ClosePolicyViolation, "device revoked")
+		return nil
+	})
+	if err := sub.Subscribe(eventbus.TopicUserEvents, users.Handle); err != nil {
+		return err
+	}
+
+	mux := eventbus.NewEventMux()
+	eventbus.HandleEvent(mux, func(_ context.Context, _ *pb.EventEnvelope, ev *pb.MessageSent) error {
+		if ev.SenderUserId != "" {
+			// Clients drop the sender's typing notice once the message shows up.
+			h.clearTyping(ev.ChatId, ev.SenderUserId)
+		}
+		h.deliver(ev.RecipientUserIds, FrameMessageNew, MessageData{
+			ChatID:           ev.ChatId,
+			MessageID:        ev.MessageId,
+			SenderUserID:     ev.SenderUserId,
+			ContentType:      ev.ContentType,
+			Content:          ev.Content,
+			MediaURL:         ev.MediaUrl,
+			ClientMessageID:  ev.ClientMessageId,
+			ReplyToMessageID: ev.ReplyToMessageId,
+			ReplyToStatusID:  ev.ReplyToStatusId,
+			Forwarded:        ev.Forwarded,
+			CreatedAt:        ev.CreatedAt,
+		})
+		return nil
+	})
+	eventbus.HandleEvent(mux, func(_ context.Context, _ *pb.EventEnvelope, ev *pb.MessageEdited) error {
+		h.deliver(ev.RecipientUserIds, FrameMessageEdit, MessageEditData{
+			ChatID:       ev.ChatId,
+			MessageID:    ev.MessageId,
+			EditorUserID: ev.EditorUserId,
+			Content:      ev.Content,
+			EditedAt:     ev.EditedAt,
+		})
+		return nil
+	})
+	eventbus.HandleEvent(mux, func(_ context.Context, _ *pb.EventEnvelope, ev *pb.MessageDeleted) error {
+		h.deliver(ev.RecipientUserIds, FrameMessageDelete, MessageDeleteData{
+			ChatID:          ev.ChatId,
+			MessageID:       ev.MessageId,
+			DeletedByUserID: ev.DeletedByUserId,
+			ForEveryone:     ev.ForEveryone,
+			DeletedAt:       ev.DeletedAt,
+		})
+		return nil
+	})
+	eventbus.HandleEvent(mux, func(_ context.Context, _ *pb.EventEnvelope, ev *pb.ReceiptUpdated) error {
+		h.deliver([]string{ev.SenderUserId}, FrameMessageReceipt, ReceiptData{
+			ChatID:       ev.ChatId,
+			SenderUserID: ev.SenderUserId,
+			ReaderUserID: ev.ReaderUserId,
+			Status:       ev.Status,
+			MessageIDs:   ev.MessageIds,
+			Timestamp:    ev.At,
+		})
+		return nil
+	})
+	eventbus.HandleEvent(mux, func(_ context.Context, _ *pb.EventEnvelope, ev *pb.ReactionChanged) error {
+		h.deliver(ev.RecipientUserIds, FrameMessageReaction, ReactionData{
+			ChatID:    ev.ChatId,
+			MessageID: ev.MessageId,
+			UserID:    ev.UserId,
+			Emoji:     ev.Emoji,
+			Timestamp: ev.At,
+		})
+		return nil
+	})
+	eventbus.HandleEvent(mux, func(_ context.Context, _ *pb.EventEnvelope, ev *pb.PollUpdated) error {
+		data := PollUpdateData{
+			ChatID:      ev.ChatId,
+			PollID:      ev.PollId,
+			UserID:      ev.UserId,
+			Closed:      ev.Closed,
+			Counts:      make([]PollOptionCount, 0, len(ev.Counts)),
+			TotalVoters: int(ev.TotalVoters),
+			Timestamp:   ev.At,
+		}
+		for _, c := range ev.Counts {
+			data.Counts = append(data.Counts, PollOptionCount{OptionID: c.OptionId, Count: int(c.Count)})
+		}
+		h.deliver(ev.RecipientUserIds, FramePollUpdate, data)
+		return nil
+	})
+	return sub.Subscribe(eventbus.TopicChatEvents, eventbus.SkipDuplicates(consumedEventIDs, mux.Handle))
+}
+
+// deliver pushes a frame to the recipients' devices connected to this node.
+func (h *Hub) deliver(recipients []string, frameType string, data interface{}) {
+	frame, err := encodeFrameJSON(frameType, data)
+	if err != nil {
+		log.Printf("realtime: encode %s frame: %v", frameType, err)
+		return
+	}
+	h.sendLocal(recipients, frame)
+}
@@ -0,0 +1,176 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/dykethecreator/GoApp/internal/realtime/repository"
+	"github.com/dykethecreator/GoApp/internal/realtime/store"
+	"github.com/dykethecreator/GoApp/pkg/eventbus"
+	pb "github.com/dykethecreator/GoApp/proto"
+	"github.com/gorilla/websocket"
+	"google.golang.org/protobuf/proto"
+)
+
+// newTestNode starts a clustered hub that consumes chat events from bus in its own group,
+// as cmd/realtime_service does.
+func newTestNode(t *testing.T, nodeID string, bus *eventbus.MemoryBus, conns repository.ConnectionRepository) *Hub {
+	t.Helper()
+	hub := NewHub(Repositories{}, DefaultConfig())
+	sub := bus.Group(nodeID)
+	if _, err := NewCluster(nodeID, hub, conns, bus, sub); err != nil {
+		t.Fatalf("NewCluster(%s): %v", nodeID, err)
+	}
+	if err := hub.Consume(sub); err != nil {
+		t.Fatalf("Consume(%s): %v", nodeID, err)
+	}
+	return hub
+}
+
+// connect registers a device of userID on hub without a WebSocket; frames stay in its queue.
+func connect(hub *Hub, userID string) *Client {
+	c := newClient(hub, nil, userID, userID+"-device")
+	hub.register(c)
+	return c
+}
+
+// framesOfType collects the frames of frameType queued on c until quiet passes without one.
+func framesOfType(t *testing.T, c *Client, frameType string, quiet time.Duration) []Frame {
+	t.Helper()
+	var out []Frame
+	for {
+		select {
+		case data := <-c.send:
+			var f Frame
+			if err := json.Unmarshal(data, &f); err != nil {
+				t.Fatalf("decode frame: %v", err)
+			}
+			if f.Type == frameType {
+				out = append(out, f)
+			}
+		case <-time.After(quiet):
+			return out
+		}
+	}
+}
+
+func TestConsumeDeliversOncePerDeviceAcrossNodes(t *testing.T) {
+	bus := eventbus.NewMemoryBus()
+	defer bus.Close()
+	conns := store.NewMemoryConnectionStore()
+	hubA := newTestNode(t, "node-a", bus, conns)
+	hubB := newTestNode(t, "node-b", bus, conns)
+
+	alice := connect(hubA, "alice")
+	bob := connect(hubB, "bob")
+
+	ev := &pb.MessageSent{
+		ChatId:           "chat-1",
+		MessageId:        42,
+		SenderUserId:     "alice",
+		ContentType:      "text",
+		Content:          "hi",
+		RecipientUserIds: []string{"alice", "bob"},
+	}
+	if err := eventbus.PublishEvent(context.Background(), bus, eventbus.TopicChatEvents, ev.ChatId, "test", ev); err != nil {
+		t.Fatalf("PublishEvent: %v", err)
+	}
+
+	for _, c := range []*Client{alice, bob} {
+		frames := framesOfType(t, c, FrameMessageNew, 300*time.Millisecond)
+		if len(frames) != 1 {
+			t.Fatalf("%s got %d %s frames, want 1", c.userID, len(frames), FrameMessageNew)
+		}
+		var data MessageData
+		if err := json.Unmarshal(frames[0].Data, &data); err != nil {
+			t.Fatalf("decode %s data: %v", FrameMessageNew, err)
+		}
+		if data.MessageID != 42 || data.ChatID != "chat-1" || data.Content != "hi" {
+			t.Errorf("%s got %+v", c.userID, data)
+		}
+	}
+}
+
+func TestConsumeDeliversEditsAndDeletes(t *testing.T) {
+	bus := eventbus.NewMemoryBus()
+	defer bus.Close()
+	hub := newTestNode(t, "node-a", bus, store.NewMemoryConnectionStore())
+	alice := connect(hub, "alice")
+	bob := connect(hub, "bob")
+	publish := func(ev proto.Message) {
+		t.Helper()
+		if err := eventbus.PublishEvent(context.Background(), bus, eventbus.TopicChatEvents, "chat-1", "test", ev); err != nil {
+			t.Fatalf("PublishEvent: %v", err)
+		}
+	}
+
+	publish(&pb.MessageEdited{ChatId: "chat-1", MessageId: 8, EditorUserId: "alice", Content: "hi!", RecipientUserIds: []string{"alice", "bob"}})
+	for _, c := range []*Client{alice, bob} {
+		frames := framesOfType(t, c, FrameMessageEdit, 300*time.Millisecond)
+		if len(frames) != 1 {
+			t.Fatalf("%s got %d %s frames, want 1", c.userID, len(frames), FrameMessageEdit)
+		}
+		var data MessageEditData
+		if err := json.Unmarshal(frames[0].Data, &data); err != nil {
+			t.Fatalf("decode %s data: %v", FrameMessageEdit, err)
+		}
+		if data.MessageID != 8 || data.Content != "hi!" || data.EditorUserID != "alice" {
+			t.Errorf("%s got %+v", c.userID, data)
+		}
+	}
+
+	publish(&pb.MessageDeleted{ChatId: "chat-1", MessageId: 7, DeletedByUserId: "bob", RecipientUserIds: []string{"bob"}})
+	publish(&pb.MessageDeleted{ChatId: "chat-1", MessageId: 8, DeletedByUserId: "alice", ForEveryone: true, RecipientUserIds: []string{"alice", "bob"}})
+	if frames := framesOfType(t, bob, FrameMessageDelete, 300*time.Millisecond); len(frames) != 2 {
+		t.Fatalf("bob got %d %s frames, want 2", len(frames), FrameMessageDelete)
+	}
+	frames := framesOfType(t, alice, FrameMessageDelete, 300*time.Millisecond)
+	if len(frames) != 1 {
+		t.Fatalf("alice got %d %s frames, want only the delete for everyone", len(frames), FrameMessageDelete)
+	}
+	var data MessageDeleteData
+	if err := json.Unmarshal(frames[0].Data, &data); err != nil {
+		t.Fatalf("decode %s data: %v", FrameMessageDelete, err)
+	}
+	if data.MessageID != 8 || !data.ForEveryone {
+		t.Errorf("alice got %+v", data)
+	}
+}
+
+func TestConsumeClosesRevokedDevice(t *testing.T) {
+	bus := eventbus.NewMemoryBus()
+	defer bus.Close()
+	hub := NewHub(Repositories{Users: &lastSeenRecorder{lastSeen: map[string]time.Time{}}}, DefaultConfig())
+	if err := hub.Consume(bus.Group("node-a")); err != nil {
+		t.Fatalf("Consume: %v", err)
+	}
+	revoked := connect(hub, "alice")
+	phone := newClient(hub, nil, "alice", "alice-phone")
+	hub.register(phone)
+
+	ev := &pb.DeviceRevoked{UserId: "alice", DeviceId: revoked.deviceID}
+	if err := eventbus.PublishEvent(context.Background(), bus, eventbus.TopicUserEvents, ev.UserId, "test", ev); err != nil {
+		t.Fatalf("PublishEvent: %v", err)
+	}
+	deadline := time.Now().Add(time.Second)
+	for hub.ConnectionCount("alice") != 1 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if n := hub.ConnectionCount("alice"); n != 1 {
+		t.Fatalf("ConnectionCount = %d, want only the other device left", n)
+	}
+
+	revoked.mu.Lock()
+	closed, code := revoked.closed, revoked.closeCode
+	revoked.mu.Unlock()
+	if !closed || code != websocket.ClosePolicyViolation {
+		t.Errorf("revoked device closed=%v code=%d, want closed with %d", closed, code, websocket.ClosePolicyViolation)
+	}
+	phone.mu.Lock()
+	defer phone.mu.Unlock()
+	if phone.closed {
+		t.Error("the user's other device was disconnected")
+	}
+}
@@ -0,0 +1,185 @@
+package realtime
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/dykethecreator/GoApp/pkg/domain"
+)
+
+// Frame types sent to clients.
+const (
+	FrameMessageNew      = "message.new"
+	FrameMessageEdit     = "message.edit"
+	FrameMessageDelete   = "message.delete"
+	FrameMessageReceipt  = "message.receipt"
+	FrameMessageReaction = "message.reaction"
+	FramePollUpdate      = "poll.update"
+	FrameTyping          = "typing"
+	FramePresence        = "presence"
+	FrameSyncBatch       = "sync.batch"
+	FrameSyncReset       = "sync.reset"
+	FrameError           = "error"
+)
+
+// Frame types sent by clients, besides FrameTyping.
+const (
+	FramePresenceSubscribe = "presence.subscribe"
+	FrameSync              = "sync"
+	FrameSyncAck           = "sync.ack"
+)
+
+// Typing actions.
+const (
+	TypingAction    = "typing"
+	RecordingAction = "recording" // recording a voice note
+	PausedAction    = "paused"    // stopped typing or recording, or the notice expired
+)
+
+// Frame is the JSON envelope of every WebSocket text message, in both directions.
+type Frame struct {
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+// MessageData is the data of a message.new frame, sent to every active member of the chat.
+type MessageData struct {
+	ChatID           string `json:"chat_id"`
+	MessageID        int64  `json:"message_id"`
+	SenderUserID     string `json:"sender_user_id,omitempty"` // empty for system notifications
+	ContentType      string `json:"content_type"`
+	Content          string `json:"content,omitempty"`
+	MediaURL         string `json:"media_url,omitempty"`
+	ClientMessageID  string `json:"client_message_id,omitempty"`
+	ReplyToMessageID int64  `json:"reply_to_message_id,omitempty"`
+	ReplyToStatusID  string `json:"reply_to_status_id,omitempty"`
+	Forwarded        bool   `json:"forwarded,omitempty"`
+	CreatedAt        string `json:"created_at"`
+}
+
+// MessageEditData is the data of a message.edit frame, sent to every active member of the
+// chat.
+type MessageEditData struct {
+	ChatID       string `json:"chat_id"`
+	MessageID    int64  `json:"message_id"`
+	EditorUserID string `json:"editor_user_id"`
+	Content      string `json:"content"`
+	EditedAt     string `json:"edited_at"`
+}
+
+// MessageDeleteData is the data of a message.delete frame. A message deleted for everyone
+// or expired is announced to every active member; one the user only hid for themselves
+// goes to that user's devices alone.
+type MessageDeleteData struct {
+	ChatID          string `json:"chat_id"`
+	MessageID       int64  `json:"message_id"`
+	DeletedByUserID string `json:"deleted_by_user_id,omitempty"` // empty when the message expired
+	ForEveryone     bool   `json:"for_everyone"`
+	DeletedAt       string `json:"deleted_at"`
+}
+
+// ReceiptData is the data of a message.receipt frame, sent to the sender of the messages.
+type ReceiptData struct {
+	ChatID       string  `json:"chat_id"`
+	SenderUserID string  `json:"sender_user_id"`
+	ReaderUserID string  `json:"reader_user_id"` // the user who received/read the messages
+	Status       string  `json:"status"`         // 'delivered' or 'read'
+	MessageIDs   []int64 `json:"message_ids"`
+	Timestamp    string  `json:"timestamp"`
+}
+
+// ReactionData is the data of a message.reaction frame.
+type ReactionData struct {
+	ChatID    string `json:"chat_id"`
+	MessageID int64  `json:"message_id"`
+	UserID    string `json:"user_id"`
+	Emoji     string `json:"emoji"` // empty when the reaction was removed
+	Timestamp string `json:"timestamp"`
+}
+
+// PollUpdateData is the data of a poll.update frame. It carries the whole tally.
+type PollUpdateData struct {
+	ChatID      string            `json:"chat_id"`
+	PollID      string            `json:"poll_id"`
+	UserID      string            `json:"user_id"` // who voted, retracted or closed the poll
+	Closed      bool              `json:"closed"`
+	Counts      []PollOptionCount `json:"counts"`
+	TotalVoters int               `json:"total_voters"`
+	Timestamp   string            `json:"timestamp"`
+}
+
+// PollOptionCount is the number of votes one poll option has.
+type PollOptionCount struct {
+	OptionID int64 `json:"option_id"`
+	Count    int   `json:"count"`
+}
+
+// TypingData is the data of a typing frame. Clients send ChatID and Action (typing when
+// empty); the hub fills in the rest before relaying it to the chat's other members.
+type TypingData struct {
+	ChatID    string     `json:"chat_id"`
+	Action    string     `json:"action"`
+	UserID    string     `json:"user_id,omitempty"`
+	Timestamp time.Time  `json:"timestamp,omitempty"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"` // when the notice lapses unless repeated
+}
+
+// PresenceSubscribeData is the data of a presence.subscribe frame. It replaces the users
+// the connection watches; an empty list stops watching.
+type PresenceSubscribeData struct {
+	UserIDs []string `json:"user_ids"`
+}
+
+// PresenceData is the data of a presence frame. Online is false both when the user is
+// offline and when their privacy settings hide their online status from the watcher.
+type PresenceData struct {
+	UserID     string     `json:"user_id"`
+	Online     bool       `json:"online"`
+	LastSeenAt *time.Time `json:"last_seen_at,omitempty"` // omitted when hidden by privacy settings
+}
+
+// SyncData is the data of a sync frame, asking for the next page of changes the device
+// missed. Without AfterSeq the page starts at the device's cursor.
+type SyncData struct {
+	AfterSeq *int64 `json:"after_seq,omitempty"`
+	Limit    int    `json:"limit,omitempty"` // at most the hub's batch size when zero or larger
+}
+
+// SyncBatchData is the data of a sync.batch frame. Events come in the order they are to be
+// applied, which is not always seq order.
+type SyncBatchData struct {
+	Events []*domain.SyncEvent `json:"events"`
+	// NextSeq is where the following page starts: pass it as after_seq to page on and
+	// acknowledge it once the events are stored. It is 0 while the log is empty.
+	NextSeq int64 `json:"next_seq"`
+	HasMore bool  `json:"has_more"`
+}
+
+// A sync.reset frame, which has no data, answers a sync frame when changes the device
+// missed were pruned from the log. The device reloads its chats with GetMessages and then
+// syncs from its cursor again, which the hub has moved past the gap.
+
+// SyncAckData is the data of a sync.ack frame. It moves the device's cursor to Seq, a
+// next_seq the device received, so later syncs resume from there.
+type SyncAckData struct {
+	Seq int64 `json:"seq"`
+}
+
+// ErrorData is the data of an error frame, sent when a client frame is rejected.
+type ErrorData struct {
+	Message string `json:"message"`
+}
+
+// encodeFrame wraps data, already JSON encoded, in a frame of the given type.
+func encodeFrame(frameType string, data json.RawMessage) ([]byte, error) {
+	return json.Marshal(Frame{Type: frameType, Data: data})
+}
+
+// encodeFrameJSON encodes payload as JSON and wraps it in a frame of the given type.
+func encodeFrameJSON(frameType string, payload interface{}) ([]byte, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	return encodeFrame(frameType, data)
+}
@@ -1,7 +1,305 @@
 package realtime
 
-// Hub manages WebSocket connections
-type Hub struct{}
+import (
+	"encoding/json"
+	"sync"
+	"time"
 
-// Client is a WebSocket client
-type Client struct{}
+	"github.com/dykethecreator/GoApp/internal/realtime/repository"
+	"github.com/gorilla/websocket"
+)
+
+// Config holds the connection limits of the hub.
+type Config struct {
+	// WriteWait bounds a single write to a client.
+	WriteWait time.Duration
+	// PongWait is how long a client may stay silent (no frame, no pong) before it is dropped.
+	PongWait time.Duration
+	// PingPeriod is how often the hub pings clients; it must be shorter than PongWait.
+	PingPeriod time.Duration
+	// MaxFrameSize bounds frames read from clients, in bytes.
+	MaxFrameSize int64
+	// SendQueueSize is how many frames may wait for a client before it counts as a slow
+	// consumer and is disconnected.
+	SendQueueSize int
+	// LookupTimeout bounds the database lookups done while handling a client frame.
+	LookupTimeout time.Duration
+	// TypingTTL is how long a typing or recording notice lasts unless the client repeats it.
+	TypingTTL time.Duration
+	// MaxPresenceSubscriptions bounds how many users one connection may watch.
+	MaxPresenceSubscriptions int
+	// SyncBatchSize is the most events one sync.batch frame carries.
+	SyncBatchSize int
+}
+
+// DefaultConfig returns the limits used when nothing is configured.
+func DefaultConfig() Config {
+	return Config{
+		WriteWait:     10 * time.Second,
+		PongWait:      60 * time.Second,
+		PingPeriod:    54 * time.Second,
+		MaxFrameSize:  64 * 1024,
+		SendQueueSize: 256,
+		LookupTimeout: 5 * time.Second,
+		TypingTTL:     6 * time.Second,
+
+		MaxPresenceSubscriptions: 500,
+		SyncBatchSize:            100,
+	}
+}
+
+// Repositories groups the data-layer dependencies of Hub.
+type Repositories struct {
+	Members repository.MemberRepository
+	Users   repository.UserRepository
+	Privacy repository.PrivacyRepository
+	Sync    repository.SyncRepository
+}
+
+// Hub tracks the open connections of every user on this node and delivers frames to them.
+// Joined to a Cluster, it also reaches users connected to other nodes.
+type Hub struct {
+	cfg         Config
+	members     repository.MemberRepository
+	users       repository.UserRepository
+	privacyRepo repository.PrivacyRepository
+	syncRepo    repository.SyncRepository
+
+	mu      sync.RWMutex
+	clients map[string]map[*Client]struct{} // user ID -> that user's connections
+
+	typingMu sync.Mutex
+	typing   map[typingKey]*typingState
+
+	presenceMu sync.Mutex
+	watchers   map[string]map[*Client]presenceGrant // watched user ID -> watching connections
+
+	cluster *Cluster // nil when the hub serves a single node on its own
+}
+
+func NewHub(repos Repositories, cfg Config) *Hub {
+	return &Hub{
+		cfg:         cfg,
+		members:     repos.Members,
+		users:       repos.Users,
+		privacyRepo: repos.Privacy,
+		syncRepo:    repos.Sync,
+		clients:     map[string]map[*Client]struct{}{},
+		typing:      map[typingKey]*typingState{},
+		watchers:    map[string]map[*Client]presenceGrant{},
+	}
+}
+
+// Attach registers a new connection for userID's device and starts serving it. It returns
+// immediately; the connection is served until either side closes it or, when expiresAt is
+// set, until then. An empty deviceID gets a random one, good for this connection only; such
+// connections cannot sync.
+func (h *Hub) Attach(conn *websocket.Conn, userID string, deviceID string, expiresAt time.Time) *Client {
+	c := newClient(h, conn, userID, deviceID)
+	h.register(c)
+	if !expiresAt.IsZero() {
+		c.expireAt(expiresAt)
+	}
+	go c.writePump()
+	go c.readPump()
+	return c
+}
+
+// register adds the connection; the user's first one, on any node, brings them online.
+func (h *Hub) register(c *Client) {
+	h.mu.Lock()
+	conns := h.clients[c.userID]
+	if conns == nil {
+		conns = map[*Client]struct{}{}
+		h.clients[c.userID] = conns
+	}
+	conns[c] = struct{}{}
+	first := len(conns) == 1
+	h.mu.Unlock()
+
+	if h.cluster != nil {
+		first = h.cluster.add(c, first)
+	}
+	if first {
+		h.wentOnline(c.userID)
+	}
+}
+
+// unregister forgets the connection; calling it more than once is harmless. The user's
+// last connection, on any node, going away takes them offline.
+func (h *Hub) unregister(c *Client) {
+	h.mu.Lock()
+	conns := h.clients[c.userID]
+	if _, ok := conns[c]; !ok {
+		h.mu.Unlock()
+		return
+	}
+	delete(conns, c)
+	last := len(conns) == 0
+	if last {
+		delete(h.clients, c.userID)
+	}
+	h.mu.Unlock()
+
+	if h.cluster != nil {
+		last = h.cluster.remove(c, last)
+	}
+	h.unwatchAll(c)
+	if last {
+		h.wentOffline(c.userID, time.Now())
+	}
+}
+
+// IsOnline reports whether userID has a connection to this node or, in a cluster, any node.
+func (h *Hub) IsOnline(userID string) bool {
+	if h.cluster != nil {
+		return h.cluster.isOnline(userID)
+	}
+	return h.ConnectionCount(userID) > 0
+}
+
+// onlineUsers reports which of userIDs have a device connected to any node, looking them
+// all up at once.
+func (h *Hub) onlineUsers(userIDs []string) map[string]bool {
+	if h.cluster != nil {
+		return h.cluster.onlineUsers(userIDs)
+	}
+	return h.localOnlineUsers(userIDs)
+}
+
+// localOnlineUsers reports which of userIDs have a device connected to this node.
+func (h *Hub) localOnlineUsers(userIDs []string) map[string]bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	out := make(map[string]bool, len(userIDs))
+	for _, id := range userIDs {
+		if len(h.clients[id]) > 0 {
+			out[id] = true
+		}
+	}
+	return out
+}
+
+// ConnectionCount returns how many devices userID has connected to this node.
+func (h *Hub) ConnectionCount(userID string) int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return len(h.clients[userID])
+}
+
+// connections snapshots userID's connections so frames can be queued without holding mu.
+func (h *Hub) connections(userID string) []*Client {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	conns := make([]*Client, 0, len(h.clients[userID]))
+	for c := range h.clients[userID] {
+		conns = append(conns, c)
+	}
+	return conns
+}
+
+// DisconnectDevice closes userID's connections from deviceID on this node and returns how
+// many there were.
+func (h *Hub) DisconnectDevice(userID string, deviceID string, code int, text string) int {
+	n := 0
+	for _, c := range h.connections(userID) {
+		if c.deviceID == deviceID {
+			c.disconnect(code, text)
+			n++
+		}
+	}
+	return n
+}
+
+// SendToUser queues frame on every connection of userID and returns how many accepted it.
+func (h *Hub) SendToUser(userID string, frame []byte) int {
+	n := 0
+	for _, c := range h.connections(userID) {
+		if c.enqueue(frame) {
+			n++
+		}
+	}
+	return n
+}
+
+// SendToUsers delivers frame to every connection of each user in userIDs except
+// skipUserID, on whichever node serves it.
+func (h *Hub) SendToUsers(userIDs []string, skipUserID string, frame []byte) {
+	targets := make([]string, 0, len(userIDs))
+	for _, id := range userIDs {
+		if id != skipUserID {
+			targets = append(targets, id)
+		}
+	}
+	if len(targets) == 0 {
+		return
+	}
+	if h.cluster != nil {
+		h.cluster.route(targets, frame)
+		return
+	}
+	h.sendLocal(targets, frame)
+}
+
+// sendLocal queues frame on this node's connections of userIDs.
+func (h *Hub) sendLocal(userIDs []string, frame []byte) {
+	for _, id := range userIDs {
+		h.SendToUser(id, frame)
+	}
+}
+
+// Close disconnects every client, e.g. on shutdown, taking offline the users who had no
+// other connection.
+func (h *Hub) Close() {
+	h.mu.RLock()
+	var all []*Client
+	for _, conns := range h.clients {
+		for c := range conns {
+			all = append(all, c)
+		}
+	}
+	h.mu.RUnlock()
+
+	for _, c := range all {
+		h.unregister(c)
+		c.shut(websocket.CloseGoingAway, "server shutting down")
+	}
+}
+
+// handleClientFrame acts on a frame received from c.
+func (h *Hub) handleClientFrame(c *Client, raw []byte) {
+	var f Frame
+	if err := json.Unmarshal(raw, &f); err != nil {
+		h.sendError(c, "malformed frame")
+		return
+	}
+	switch f.Type {
+	case FrameTyping:
+		h.handleTyping(c, f.Data)
+	case FramePresenceSubscribe:
+		h.handlePresenceSubscribe(c, f.Data)
+	case FrameSync:
+		h.handleSync(c, f.Data)
+	case FrameSyncAck:
+		h.handleSyncAck(c, f.Data)
+	default:
+		h.sendError(c, "unknown frame type "+f.Type)
+	}
+}
+
+func (h *Hub) sendError(c *Client, msg string) {
+	frame, err := encodeFrameJSON(FrameError, ErrorData{Message: msg})
+	if err != nil {
+		return
+	}
+	c.enqueue(frame)
+}
+
+func contains(ids []string, id string) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
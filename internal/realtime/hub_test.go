@@ -0,0 +1,135 @@
+package realtime
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// lastSeenRecorder is a UserRepository that remembers when users went offline.
+type lastSeenRecorder struct {
+	mu       sync.Mutex
+	lastSeen map[string]time.Time
+}
+
+func (r *lastSeenRecorder) FindLastSeen(_ context.Context, userIDs []string) (map[string]time.Time, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	out := map[string]time.Time{}
+	for _, id := range userIDs {
+		if t, ok := r.lastSeen[id]; ok {
+			out[id] = t
+		}
+	}
+	return out, nil
+}
+
+func (r *lastSeenRecorder) UpdateLastSeen(_ context.Context, userID string, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.lastSeen[userID] = at
+	return nil
+}
+
+func TestSlowConsumerIsDisconnected(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.SendQueueSize = 2
+	users := &lastSeenRecorder{lastSeen: map[string]time.Time{}}
+	hub := NewHub(Repositories{Users: users}, cfg)
+
+	slow := connect(hub, "alice")
+	other := connect(hub, "alice") // a second device of the same user keeps up
+
+	frame := []byte(`{"type":"message.new"}`)
+	for i := 0; i < cfg.SendQueueSize; i++ {
+		if n := hub.SendToUser("alice", frame); n != 2 {
+			t.Fatalf("frame %d reached %d connections, want 2", i, n)
+		}
+		<-other.send
+	}
+	// slow's queue is full now; the next frame disconnects it and reaches only other.
+	if n := hub.SendToUser("alice", frame); n != 1 {
+		t.Fatalf("overflowing frame reached %d connections, want 1", n)
+	}
+	if n := hub.ConnectionCount("alice"); n != 1 {
+		t.Errorf("ConnectionCount = %d, want 1", n)
+	}
+
+	slow.mu.Lock()
+	closed, code := slow.closed, slow.closeCode
+	slow.mu.Unlock()
+	if !closed || code != websocket.CloseTryAgainLater {
+		t.Errorf("slow client closed=%v code=%d, want closed with %d", closed, code, websocket.CloseTryAgainLater)
+	}
+	// The queued frames are still written before the close message.
+	for i := 0; i < cfg.SendQueueSize; i++ {
+		if _, ok := <-slow.send; !ok {
+			t.Fatalf("queue closed after %d frames, want %d", i, cfg.SendQueueSize)
+		}
+	}
+	if _, ok := <-slow.send; ok {
+		t.Error("queue still open after the disconnect")
+	}
+	if slow.enqueue(frame) {
+		t.Error("a disconnected client accepted a frame")
+	}
+
+	users.mu.Lock()
+	_, offline := users.lastSeen["alice"]
+	users.mu.Unlock()
+	if offline {
+		t.Error("alice went offline while another device was connected")
+	}
+}
+
+func TestSlowConsumerLastDeviceGoesOffline(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.SendQueueSize = 1
+	users := &lastSeenRecorder{lastSeen: map[string]time.Time{}}
+	hub := NewHub(Repositories{Users: users}, cfg)
+
+	connect(hub, "bob")
+	frame := []byte(`{"type":"message.new"}`)
+	hub.SendToUser("bob", frame)
+	if n := hub.SendToUser("bob", frame); n != 0 {
+		t.Fatalf("overflowing frame reached %d connections, want 0", n)
+	}
+	if hub.IsOnline("bob") {
+		t.Error("bob is still online")
+	}
+	users.mu.Lock()
+	_, offline := users.lastSeen["bob"]
+	users.mu.Unlock()
+	if !offline {
+		t.Error("bob's last seen was not recorded")
+	}
+}
+
+func TestExpiredTokenDisconnects(t *testing.T) {
+	users := &lastSeenRecorder{lastSeen: map[string]time.Time{}}
+	hub := NewHub(Repositories{Users: users}, DefaultConfig())
+	c := connect(hub, "alice")
+	c.expireAt(time.Now().Add(20 * time.Millisecond))
+
+	deadline := time.Now().Add(time.Second)
+	for hub.ConnectionCount("alice") != 0 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if n := hub.ConnectionCount("alice"); n != 0 {
+		t.Fatalf("ConnectionCount = %d after the token expired, want 0", n)
+	}
+	c.mu.Lock()
+	closed, code := c.closed, c.closeCode
+	c.mu.Unlock()
+	if !closed || code != websocket.ClosePolicyViolation {
+		t.Errorf("client closed=%v code=%d, want closed with %d", closed, code, websocket.ClosePolicyViolation)
+	}
+	users.mu.Lock()
+	defer users.mu.Unlock()
+	if _, ok := users.lastSeen["alice"]; !ok {
+		t.Error("alice's only device expired but she did not go offline")
+	}
+}
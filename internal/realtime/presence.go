@@ -0,0 +1,197 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/google/uuid"
+)
+
+// presenceGrant records what a watcher may learn about a watched user's presence, decided
+// by the user's privacy settings when the watcher subscribed.
+type presenceGrant struct {
+	online   bool // the "online" setting admits the watcher
+	lastSeen bool // the "last seen" setting admits the watcher
+}
+
+// handlePresenceSubscribe replaces the users c watches, typically the contacts on screen,
+// and answers with their current presence. Users whose privacy settings hide both their
+// online status and last seen from the caller, or who blocked or were blocked by the
+// caller, are silently left out.
+func (h *Hub) handlePresenceSubscribe(c *Client, data json.RawMessage) {
+	var in PresenceSubscribeData
+	if err := json.Unmarshal(data, &in); err != nil {
+		h.sendError(c, "malformed presence.subscribe frame")
+		return
+	}
+	if len(in.UserIDs) > h.cfg.MaxPresenceSubscriptions {
+		h.sendError(c, "too many presence subscriptions")
+		return
+	}
+
+	var ids []string
+	seen := map[string]bool{}
+	for _, id := range in.UserIDs {
+		if _, err := uuid.Parse(id); err != nil {
+			h.sendError(c, "invalid user id "+id)
+			return
+		}
+		if id == c.userID || seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), h.cfg.LookupTimeout)
+	defer cancel()
+	grants, err := h.presenceGrants(ctx, ids, c.userID)
+	if err != nil {
+		log.Printf("realtime: presence privacy of %d users: %v", len(ids), err)
+		return
+	}
+	var watched []string
+	for _, id := range ids {
+		if g := grants[id]; g.online || g.lastSeen {
+			watched = append(watched, id)
+		}
+	}
+
+	h.unwatchAll(c)
+	h.presenceMu.Lock()
+	for _, id := range watched {
+		w := h.watchers[id]
+		if w == nil {
+			w = map[*Client]presenceGrant{}
+			h.watchers[id] = w
+		}
+		w[c] = grants[id]
+	}
+	c.watching = watched
+	h.presenceMu.Unlock()
+
+	online := h.onlineUsers(watched)
+	var offline []string
+	for _, id := range watched {
+		if !online[id] {
+			offline = append(offline, id)
+		}
+	}
+	lastSeen, err := h.users.FindLastSeen(ctx, offline)
+	if err != nil {
+		log.Printf("realtime: load last seen: %v", err)
+		lastSeen = nil
+	}
+	for _, id := range watched {
+		g := grants[id]
+		p := PresenceData{UserID: id, Online: g.online && online[id]}
+		if at, ok := lastSeen[id]; ok && g.lastSeen && !p.Online {
+			p.LastSeenAt = &at
+		}
+		h.sendPresence(c, p)
+	}
+}
+
+// presenceGrants decides what viewerID may see of each owner's presence. Owners missing
+// from the result may see nothing.
+func (h *Hub) presenceGrants(ctx context.Context, ownerIDs []string, viewerID string) (map[string]presenceGrant, error) {
+	viewer, err := uuid.Parse(viewerID)
+	if err != nil {
+		return nil, err
+	}
+	views, err := h.privacyRepo.FindForViewer(ctx, ownerIDs, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	grants := make(map[string]presenceGrant, len(views))
+	for id, v := range views {
+		if v.Blocked {
+			continue
+		}
+		grants[id] = presenceGrant{
+			online:   v.Settings.Allows(domain.OnlineSetting, viewer, v.IsContact),
+			lastSeen: v.Settings.Allows(domain.LastSeenSetting, viewer, v.IsContact),
+		}
+	}
+	return grants, nil
+}
+
+// unwatchAll drops every presence subscription of c.
+func (h *Hub) unwatchAll(c *Client) {
+	h.presenceMu.Lock()
+	defer h.presenceMu.Unlock()
+	for _, id := range c.watching {
+		if w := h.watchers[id]; w != nil {
+			delete(w, c)
+			if len(w) == 0 {
+				delete(h.watchers, id)
+			}
+		}
+	}
+	c.watching = nil
+}
+
+// wentOnline tells the user's watchers, on every node, that they connected.
+func (h *Hub) wentOnline(userID string) {
+	if h.cluster != nil {
+		h.cluster.publishPresence(userID, true, time.Now())
+		return
+	}
+	h.notifyPresence(userID, true, time.Now())
+}
+
+// wentOffline records the user's last seen time once their last device disconnected,
+// ends their typing notices on this node and tells their watchers on every node.
+func (h *Hub) wentOffline(userID string, at time.Time) {
+	ctx, cancel := context.WithTimeout(context.Background(), h.cfg.LookupTimeout)
+	defer cancel()
+	if err := h.users.UpdateLastSeen(ctx, userID, at); err != nil {
+		log.Printf("realtime: update last seen of user %s: %v", userID, err)
+	}
+	h.stopAllTyping(userID)
+	if h.cluster != nil {
+		h.cluster.publishPresence(userID, false, at)
+		return
+	}
+	h.notifyPresence(userID, false, at)
+}
+
+// notifyPresence tells this node's watchers of userID that they came online or went
+// offline at at, as far as each watcher may know.
+func (h *Hub) notifyPresence(userID string, online bool, at time.Time) {
+	for c, g := range h.watchersOf(userID) {
+		p := PresenceData{UserID: userID}
+		switch {
+		case online && g.online:
+			p.Online = true
+		case online:
+			continue // hidden; the watcher keeps the last seen it has
+		case g.lastSeen:
+			p.LastSeenAt = &at
+		}
+		h.sendPresence(c, p)
+	}
+}
+
+// watchersOf snapshots the connections watching userID.
+func (h *Hub) watchersOf(userID string) map[*Client]presenceGrant {
+	h.presenceMu.Lock()
+	defer h.presenceMu.Unlock()
+	out := make(map[*Client]presenceGrant, len(h.watchers[userID]))
+	for c, g := range h.watchers[userID] {
+		out[c] = g
+	}
+	return out
+}
+
+func (h *Hub) sendPresence(c *Client, p PresenceData) {
+	frame, err := encodeFrameJSON(FramePresence, p)
+	if err != nil {
+		log.Printf("realtime: encode presence frame: %v", err)
+		return
+	}
+	c.enqueue(frame)
+}
@@ -0,0 +1,71 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/dykethecreator/GoApp/internal/realtime/repository"
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/google/uuid"
+)
+
+// privacyTable is a PrivacyRepository over fixed views that counts its lookups.
+type privacyTable struct {
+	views   map[string]*repository.PrivacyView
+	lookups int
+}
+
+func (p *privacyTable) FindForViewer(_ context.Context, ownerIDs []string, _ string) (map[string]*repository.PrivacyView, error) {
+	p.lookups++
+	out := map[string]*repository.PrivacyView{}
+	for _, id := range ownerIDs {
+		if v, ok := p.views[id]; ok {
+			out[id] = v
+		} else {
+			out[id] = &repository.PrivacyView{Settings: domain.DefaultPrivacySettings(uuid.MustParse(id))}
+		}
+	}
+	return out, nil
+}
+
+func TestPresenceSubscribeLooksUpPrivacyOnce(t *testing.T) {
+	alice, bob, carol, dave := uuid.NewString(), uuid.NewString(), uuid.NewString(), uuid.NewString()
+	seen := time.Now().Add(-time.Hour).UTC()
+	hidden := domain.DefaultPrivacySettings(uuid.MustParse(carol))
+	hidden.Audiences[domain.OnlineSetting] = domain.AudienceNobody
+	privacy := &privacyTable{views: map[string]*repository.PrivacyView{
+		carol: {Settings: hidden},
+		dave:  {Settings: domain.DefaultPrivacySettings(uuid.MustParse(dave)), Blocked: true},
+	}}
+	users := &lastSeenRecorder{lastSeen: map[string]time.Time{carol: seen}}
+	hub := NewHub(Repositories{Users: users, Privacy: privacy}, DefaultConfig())
+
+	watcher := connect(hub, alice)
+	connect(hub, bob)
+
+	data, _ := json.Marshal(PresenceSubscribeData{UserIDs: []string{bob, carol, dave, bob, alice}})
+	hub.handlePresenceSubscribe(watcher, data)
+
+	if privacy.lookups != 1 {
+		t.Errorf("%d privacy lookups, want 1", privacy.lookups)
+	}
+	got := map[string]PresenceData{}
+	for _, f := range framesOfType(t, watcher, FramePresence, 100*time.Millisecond) {
+		var p PresenceData
+		if err := json.Unmarshal(f.Data, &p); err != nil {
+			t.Fatalf("decode %s data: %v", FramePresence, err)
+		}
+		got[p.UserID] = p
+	}
+	if len(got) != 2 {
+		t.Fatalf("got presence of %d users, want bob and carol: %+v", len(got), got)
+	}
+	if !got[bob].Online {
+		t.Errorf("bob: %+v, want online", got[bob])
+	}
+	if p := got[carol]; p.Online || p.LastSeenAt == nil || !p.LastSeenAt.Equal(seen) {
+		t.Errorf("carol: %+v, want offline, last seen at %s", p, seen)
+	}
+}
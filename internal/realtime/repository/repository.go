@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/dykethecreator/GoApp/pkg/domain"
+)
+
+// MemberRepository reads chat memberships. Chats are managed by the chat service.
+type MemberRepository interface {
+	// ListActiveMemberIDs returns the user IDs of the chat's active members.
+	ListActiveMemberIDs(ctx context.Context, chatID string) ([]string, error)
+	// FindChatType returns whether the chat is one-to-one or a group.
+	FindChatType(ctx context.Context, chatID string) (domain.ChatType, error)
+}
+
+// MessageRepository reads messages. They are written by the chat service, whose message
+// store implements it.
+type MessageRepository interface {
+	// FindVisible returns those of the given messages that still exist and that userID has
+	// not deleted for themselves.
+	FindVisible(ctx context.Context, messageIDs []int64, userID string) ([]*domain.Message, error)
+}
+
+// UserRepository tracks when users were last connected.
+type UserRepository interface {
+	// FindLastSeen returns when each of userIDs last went offline; users who never did are
+	// left out.
+	FindLastSeen(ctx context.Context, userIDs []string) (map[string]time.Time, error)
+	// UpdateLastSeen records that userID went offline at at.
+	UpdateLastSeen(ctx context.Context, userID string, at time.Time) error
+}
+
+// PrivacyView is one user's privacy settings together with how they relate to a viewer.
+type PrivacyView struct {
+	Settings  *domain.PrivacySettings
+	IsContact bool // the user has the viewer in their synced contacts
+	Blocked   bool // either of them has blocked the other
+}
+
+// PrivacyRepository reads users' privacy settings and blocks. They are edited through the
+// auth and chat services.
+type PrivacyRepository interface {
+	// FindForViewer returns the privacy view of each of ownerIDs as seen by viewerID. Users
+	// who never changed their settings get the defaults.
+	FindForViewer(ctx context.Context, ownerIDs []string, viewerID string) (map[string]*PrivacyView, error)
+}
+
+// Connection is one open WebSocket connection, served by one realtime node.
+type Connection struct {
+	ID       string
+	UserID   string
+	DeviceID string
+	NodeID   string
+}
+
+// ConnectionRepository is the cluster-wide registry of open connections, mapping each
+// user's devices to the nodes serving them.
+type ConnectionRepository interface {
+	// Add records the connection and returns how many live connections its user now has.
+	Add(ctx context.Context, conn Connection) (int, error)
+	// Remove forgets the connection and returns how many live connections its user has left.
+	Remove(ctx context.Context, conn Connection) (int, error)
+	// Count returns how many live connections userID has.
+	Count(ctx context.Context, userID string) (int, error)
+	// Nodes returns the nodes each of userIDs is connected to; users without live
+	// connections are left out.
+	Nodes(ctx context.Context, userIDs []string) (map[string][]string, error)
+	// Heartbeat marks nodeID's connections live. Connections of nodes that stop sending
+	// heartbeats, e.g. after a crash, eventually stop counting.
+	Heartbeat(ctx context.Context, nodeID string) error
+}
+
+// SyncPosition is a place in the sync log, just after the event Seq written by
+// transaction TxID. The zero value is the start of the log.
+type SyncPosition struct {
+	TxID uint64
+	Seq  int64
+}
+
+// SyncPage is one page of the sync log as seen by one user.
+type SyncPage struct {
+	Events []*domain.SyncEvent
+	// Next is where the following page starts. It may lie past the last event returned
+	// when trailing events turned out to be hidden from the user.
+	Next    SyncPosition
+	HasMore bool
+}
+
+// SyncRepository reads the chat change log devices catch up from and keeps each device's
+// position in it. The log is written by the chat service.
+type SyncRepository interface {
+	// ListEvents returns up to limit events visible to userID that come after after, in
+	// log order.
+	ListEvents(ctx context.Context, userID string, after SyncPosition, limit int) (*SyncPage, error)
+	// FindPosition returns the position just after the event seq, or nil when there is no
+	// such event.
+	FindPosition(ctx context.Context, seq int64) (*SyncPosition, error)
+	// FindCursor returns the position deviceID last acknowledged, the zero position when
+	// it never did.
+	FindCursor(ctx context.Context, userID string, deviceID string) (SyncPosition, error)
+	// AdvanceCursor moves deviceID's cursor to pos unless it is already further along.
+	AdvanceCursor(ctx context.Context, userID string, deviceID string, pos SyncPosition) error
+	// PrunedAfter returns the newest position pruned from a chat userID is an active member
+	// of that lies past after and was logged after they joined, or nil when no event the
+	// user could have seen after after is gone. A device that gets a position back has a
+	// gap in its sync and must reload.
+	PrunedAfter(ctx context.Context, userID string, after SyncPosition) (*SyncPosition, error)
+	// Prune deletes up to limit events that every device cursor has passed or that were
+	// logged before before, and returns how many it deleted.
+	Prune(ctx context.Context, before time.Time, limit int) (int, error)
+}
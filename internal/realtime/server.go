@@ -0,0 +1,90 @@
+package realtime
+
+import (
+	"net/http"
+	"strings"
+
+	appjwt "github.com/dykethecreator/GoApp/pkg/jwt"
+	"github.com/gorilla/websocket"
+)
+
+// maxDeviceIDLen bounds the device ID a connection is bound to; sync cursors store at most
+// this many characters.
+const maxDeviceIDLen = 128
+
+// Server upgrades authenticated HTTP requests to WebSocket connections served by a Hub.
+type Server struct {
+	hub      *Hub
+	tokens   *appjwt.TokenManager
+	upgrader websocket.Upgrader
+}
+
+// NewServer creates a Server. allowedOrigins lists the Origin headers browsers may connect
+// from; when empty any origin is accepted, which is safe because connections are
+// authenticated by access token rather than cookies.
+func NewServer(hub *Hub, tokens *appjwt.TokenManager, allowedOrigins []string) *Server {
+	s := &Server{hub: hub, tokens: tokens}
+	s.upgrader = websocket.Upgrader{
+		ReadBufferSize:  4096,
+		WriteBufferSize: 4096,
+		CheckOrigin: func(r *http.Request) bool {
+			origin := r.Header.Get("Origin")
+			if len(allowedOrigins) == 0 || origin == "" {
+				return true
+			}
+			for _, o := range allowedOrigins {
+				if strings.EqualFold(o, origin) {
+					return true
+				}
+			}
+			return false
+		},
+	}
+	return s
+}
+
+// ServeHTTP authenticates the request and upgrades it. The access token comes from an
+// "Authorization: Bearer" header or, for browsers that cannot set headers on WebSocket
+// requests, the access_token query parameter. The connection belongs to the device session
+// the token was issued to; a device_id, if given, must name that session. The connection
+// is closed once the token expires, so clients reconnect with a fresh one.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	claims, ok := s.authenticate(r)
+	if !ok {
+		http.Error(w, "invalid or expired access token", http.StatusUnauthorized)
+		return
+	}
+	if deviceID := r.URL.Query().Get("device_id"); deviceID != "" && deviceID != claims.DeviceID {
+		http.Error(w, "device_id does not match the access token", http.StatusForbidden)
+		return
+	}
+
+	conn, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return // Upgrade has already answered the request
+	}
+	s.hub.Attach(conn, claims.Subject, claims.DeviceID, claims.ExpiresAt.Time)
+}
+
+// authenticate validates the request's access token and returns its claims. Tokens must
+// name a user and a device and carry an expiry.
+func (s *Server) authenticate(r *http.Request) (*appjwt.CustomClaims, bool) {
+	token := r.URL.Query().Get("access_token")
+	if auth := r.Header.Get("Authorization"); auth != "" {
+		if !strings.HasPrefix(strings.ToLower(auth), "bearer ") {
+			return nil, false
+		}
+		token = strings.TrimSpace(auth[len("bearer "):])
+	}
+	if token == "" {
+		return nil, false
+	}
+	claims, err := s.tokens.ValidateToken(token)
+	if err != nil || claims.Type != appjwt.TokenTypeAccess || claims.Subject == "" {
+		return nil, false
+	}
+	if claims.DeviceID == "" || len(claims.DeviceID) > maxDeviceIDLen || claims.ExpiresAt == nil {
+		return nil, false
+	}
+	return claims, true
+}
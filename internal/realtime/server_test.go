@@ -0,0 +1,45 @@
+package realtime
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	appjwt "github.com/dykethecreator/GoApp/pkg/jwt"
+)
+
+func TestServeHTTPBindsTokenDevice(t *testing.T) {
+	tm, err := appjwt.NewTokenManager("test-secret-test-secret-test-secret", time.Minute, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := NewServer(NewHub(Repositories{}, DefaultConfig()), tm, nil)
+	access, _, err := tm.GenerateTokens("alice", "alice-phone")
+	if err != nil {
+		t.Fatal(err)
+	}
+	unbound, _, err := tm.GenerateTokens("alice", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		want  int
+	}{
+		{"device of another session", "access_token=" + access + "&device_id=alice-laptop", http.StatusForbidden},
+		{"token without a device", "access_token=" + unbound, http.StatusUnauthorized},
+		{"no token", "device_id=alice-phone", http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			srv.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/ws?"+tt.query, nil))
+			if rec.Code != tt.want {
+				t.Errorf("status = %d, want %d", rec.Code, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package store
+
+import (
+	"context"
+	"sync"
+
+	"github.com/dykethecreator/GoApp/internal/realtime/repository"
+)
+
+// MemoryConnectionStore implements ConnectionRepository in process memory, for a single
+// node or tests. Its connections never go stale.
+type MemoryConnectionStore struct {
+	mu    sync.Mutex
+	conns map[string]repository.Connection // connection ID -> connection
+}
+
+func NewMemoryConnectionStore() repository.ConnectionRepository {
+	return &MemoryConnectionStore{conns: map[string]repository.Connection{}}
+}
+
+func (s *MemoryConnectionStore) Add(ctx context.Context, conn repository.Connection) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.conns[conn.ID] = conn
+	return s.countLocked(conn.UserID), nil
+}
+
+func (s *MemoryConnectionStore) Remove(ctx context.Context, conn repository.Connection) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.conns, conn.ID)
+	return s.countLocked(conn.UserID), nil
+}
+
+func (s *MemoryConnectionStore) Count(ctx context.Context, userID string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.countLocked(userID), nil
+}
+
+func (s *MemoryConnectionStore) countLocked(userID string) int {
+	n := 0
+	for _, c := range s.conns {
+		if c.UserID == userID {
+			n++
+		}
+	}
+	return n
+}
+
+func (s *MemoryConnectionStore) Nodes(ctx context.Context, userIDs []string) (map[string][]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	wanted := make(map[string]bool, len(userIDs))
+	for _, id := range userIDs {
+		wanted[id] = true
+	}
+	out := map[string][]string{}
+	seen := map[[2]string]bool{}
+	for _, c := range s.conns {
+		key := [2]string{c.UserID, c.NodeID}
+		if wanted[c.UserID] && !seen[key] {
+			seen[key] = true
+			out[c.UserID] = append(out[c.UserID], c.NodeID)
+		}
+	}
+	return out, nil
+}
+
+func (s *MemoryConnectionStore) Heartbeat(ctx context.Context, nodeID string) error {
+	return nil
+}
@@ -0,0 +1,111 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/dykethecreator/GoApp/internal/realtime/repository"
+	"github.com/lib/pq"
+)
+
+// ConnectionStore implements ConnectionRepository for PostgreSQL, shared by all nodes.
+type ConnectionStore struct {
+	db *sql.DB
+	// staleAfter is how long a connection counts without a heartbeat from its node.
+	staleAfter time.Duration
+}
+
+// NewConnectionStore creates a ConnectionStore. Nodes must call Heartbeat more often than
+// staleAfter.
+func NewConnectionStore(db *sql.DB, staleAfter time.Duration) repository.ConnectionRepository {
+	return &ConnectionStore{db: db, staleAfter: staleAfter}
+}
+
+// live restricts rows of realtime_connections c to those heartbeated within the last $2
+// milliseconds.
+const live = `c.heartbeat_at > NOW() - $2 * INTERVAL '1 millisecond'`
+
+// queryRower is satisfied by both *sql.DB and *sql.Tx.
+type queryRower interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+func (s *ConnectionStore) Add(ctx context.Context, conn repository.Connection) (int, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	q := `INSERT INTO realtime_connections (connection_id, user_id, device_id, node_id) VALUES ($1, $2, $3, $4)
+	ON CONFLICT (connection_id) DO UPDATE SET heartbeat_at = NOW()`
+	if _, err := tx.ExecContext(ctx, q, conn.ID, conn.UserID, conn.DeviceID, conn.NodeID); err != nil {
+		return 0, err
+	}
+	n, err := s.count(ctx, tx, conn.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return n, tx.Commit()
+}
+
+func (s *ConnectionStore) Remove(ctx context.Context, conn repository.Connection) (int, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM realtime_connections WHERE connection_id = $1`, conn.ID); err != nil {
+		return 0, err
+	}
+	n, err := s.count(ctx, tx, conn.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return n, tx.Commit()
+}
+
+func (s *ConnectionStore) Count(ctx context.Context, userID string) (int, error) {
+	return s.count(ctx, s.db, userID)
+}
+
+func (s *ConnectionStore) count(ctx context.Context, db queryRower, userID string) (int, error) {
+	q := `SELECT COUNT(*) FROM realtime_connections c WHERE c.user_id = $1 AND ` + live
+	var n int
+	err := db.QueryRowContext(ctx, q, userID, s.staleAfter.Milliseconds()).Scan(&n)
+	return n, err
+}
+
+func (s *ConnectionStore) Nodes(ctx context.Context, userIDs []string) (map[string][]string, error) {
+	out := map[string][]string{}
+	if len(userIDs) == 0 {
+		return out, nil
+	}
+	q := `SELECT DISTINCT c.user_id, c.node_id FROM realtime_connections c
+	WHERE c.user_id = ANY($1::uuid[]) AND ` + live
+	rows, err := s.db.QueryContext(ctx, q, pq.Array(userIDs), s.staleAfter.Milliseconds())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var userID, nodeID string
+		if err := rows.Scan(&userID, &nodeID); err != nil {
+			return nil, err
+		}
+		out[userID] = append(out[userID], nodeID)
+	}
+	return out, rows.Err()
+}
+
+// Heartbeat also clears out connections left behind by nodes that stopped heartbeating.
+func (s *ConnectionStore) Heartbeat(ctx context.Context, nodeID string) error {
+	if _, err := s.db.ExecContext(ctx, `UPDATE realtime_connections SET heartbeat_at = NOW() WHERE node_id = $1`, nodeID); err != nil {
+		return err
+	}
+	q := `DELETE FROM realtime_connections c WHERE NOT (` + live + `) AND c.node_id <> $1`
+	_, err := s.db.ExecContext(ctx, q, nodeID, s.staleAfter.Milliseconds())
+	return err
+}
@@ -0,0 +1,43 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/dykethecreator/GoApp/internal/realtime/repository"
+	"github.com/dykethecreator/GoApp/pkg/domain"
+)
+
+// MemberStore implements the read-only MemberRepository for PostgreSQL.
+type MemberStore struct {
+	db *sql.DB
+}
+
+func NewMemberStore(db *sql.DB) repository.MemberRepository {
+	return &MemberStore{db: db}
+}
+
+func (s *MemberStore) ListActiveMemberIDs(ctx context.Context, chatID string) ([]string, error) {
+	q := `SELECT user_id FROM chat_members WHERE chat_id = $1 AND membership_status = 'active'`
+	rows, err := s.db.QueryContext(ctx, q, chatID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		out = append(out, id)
+	}
+	return out, rows.Err()
+}
+
+func (s *MemberStore) FindChatType(ctx context.Context, chatID string) (domain.ChatType, error) {
+	var t domain.ChatType
+	err := s.db.QueryRowContext(ctx, `SELECT type FROM chats WHERE id = $1`, chatID).Scan(&t)
+	return t, err
+}
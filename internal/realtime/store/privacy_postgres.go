@@ -0,0 +1,85 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/dykethecreator/GoApp/internal/realtime/repository"
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// PrivacyStore implements the read-only PrivacyRepository for PostgreSQL.
+type PrivacyStore struct {
+	db *sql.DB
+}
+
+func NewPrivacyStore(db *sql.DB) repository.PrivacyRepository {
+	return &PrivacyStore{db: db}
+}
+
+func (s *PrivacyStore) FindForViewer(ctx context.Context, ownerIDs []string, viewerID string) (map[string]*repository.PrivacyView, error) {
+	out := make(map[string]*repository.PrivacyView, len(ownerIDs))
+	if len(ownerIDs) == 0 {
+		return out, nil
+	}
+
+	q := `SELECT u.id, ps.last_seen, ps.online, ps.profile_photo, ps.about, ps.status, ps.group_add, ps.read_receipts, ps.updated_at,
+		EXISTS (SELECT 1 FROM contacts WHERE user_id = u.id AND contact_user_id = $2),
+		EXISTS (
+			SELECT 1 FROM blocked_users
+			WHERE (blocker_user_id = u.id AND blocked_user_id = $2) OR (blocker_user_id = $2 AND blocked_user_id = u.id)
+		)
+	FROM unnest($1::uuid[]) AS u(id)
+	LEFT JOIN privacy_settings ps ON ps.user_id = u.id`
+	rows, err := s.db.QueryContext(ctx, q, pq.Array(ownerIDs), viewerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id uuid.UUID
+		var lastSeen, online, photo, about, status, groupAdd sql.NullString
+		var readReceipts sql.NullBool
+		var updatedAt sql.NullTime
+		view := &repository.PrivacyView{}
+		if err := rows.Scan(&id, &lastSeen, &online, &photo, &about, &status, &groupAdd, &readReceipts, &updatedAt, &view.IsContact, &view.Blocked); err != nil {
+			return nil, err
+		}
+		view.Settings = domain.DefaultPrivacySettings(id)
+		if lastSeen.Valid { // the user has a settings row
+			view.Settings.Audiences[domain.LastSeenSetting] = domain.PrivacyAudience(lastSeen.String)
+			view.Settings.Audiences[domain.OnlineSetting] = domain.PrivacyAudience(online.String)
+			view.Settings.Audiences[domain.ProfilePhotoSetting] = domain.PrivacyAudience(photo.String)
+			view.Settings.Audiences[domain.AboutSetting] = domain.PrivacyAudience(about.String)
+			view.Settings.Audiences[domain.StatusSetting] = domain.PrivacyAudience(status.String)
+			view.Settings.Audiences[domain.GroupAddSetting] = domain.PrivacyAudience(groupAdd.String)
+			view.Settings.ReadReceipts = readReceipts.Bool
+			view.Settings.UpdatedAt = updatedAt.Time
+		}
+		out[id.String()] = view
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	eq := `SELECT user_id, setting, excluded_user_id FROM privacy_exceptions WHERE user_id = ANY($1::uuid[])`
+	erows, err := s.db.QueryContext(ctx, eq, pq.Array(ownerIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer erows.Close()
+	for erows.Next() {
+		var owner, excluded uuid.UUID
+		var setting string
+		if err := erows.Scan(&owner, &setting, &excluded); err != nil {
+			return nil, err
+		}
+		if view := out[owner.String()]; view != nil {
+			key := domain.PrivacySetting(setting)
+			view.Settings.Exceptions[key] = append(view.Settings.Exceptions[key], excluded)
+		}
+	}
+	return out, erows.Err()
+}
@@ -0,0 +1,189 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/dykethecreator/GoApp/internal/realtime/repository"
+	"github.com/dykethecreator/GoApp/pkg/domain"
+)
+
+// SyncStore implements SyncRepository for PostgreSQL. It attaches messages to the events
+// it lists through messages.
+type SyncStore struct {
+	db       *sql.DB
+	messages repository.MessageRepository
+}
+
+func NewSyncStore(db *sql.DB, messages repository.MessageRepository) repository.SyncRepository {
+	return &SyncStore{db: db, messages: messages}
+}
+
+func (s *SyncStore) ListEvents(ctx context.Context, userID string, after repository.SyncPosition, limit int) (*repository.SyncPage, error) {
+	// Events of transactions older than the oldest one still running can no longer be
+	// overtaken, so stopping there keeps every page stable.
+	q := `SELECT e.seq, e.txid::text, e.chat_id, e.kind, e.message_id, e.payload, e.created_at
+	FROM sync_events e
+	JOIN chat_members cm ON cm.chat_id = e.chat_id AND cm.user_id = $1 AND cm.membership_status = 'active'
+	WHERE (e.txid, e.seq) > ($2::xid8, $3)
+	AND e.txid < pg_snapshot_xmin(pg_current_snapshot())
+	AND (e.user_id IS NULL OR e.user_id = $1)
+	AND e.created_at >= cm.joined_at
+	ORDER BY e.txid, e.seq
+	LIMIT $4`
+	rows, err := s.db.QueryContext(ctx, q, userID, formatTxID(after.TxID), after.Seq, limit+1)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	page := &repository.SyncPage{Next: after}
+	var messageIDs []int64
+	for rows.Next() {
+		if len(page.Events) == limit {
+			page.HasMore = true
+			break
+		}
+		var ev domain.SyncEvent
+		var txid string
+		var payload []byte
+		if err := rows.Scan(&ev.Seq, &txid, &ev.ChatID, &ev.Kind, &ev.MessageID, &payload, &ev.CreatedAt); err != nil {
+			return nil, err
+		}
+		if page.Next.TxID, err = parseTxID(txid); err != nil {
+			return nil, err
+		}
+		page.Next.Seq = ev.Seq
+		if len(payload) > 0 {
+			ev.Payload = payload
+		}
+		if ev.Kind == domain.SyncMessage {
+			messageIDs = append(messageIDs, ev.MessageID)
+		}
+		page.Events = append(page.Events, &ev)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(messageIDs) == 0 {
+		return page, nil
+	}
+
+	// Attach the messages as they are now; ones that expired or that the user hid since
+	// are left out.
+	found, err := s.messages.FindVisible(ctx, messageIDs, userID)
+	if err != nil {
+		return nil, err
+	}
+	messages := make(map[int64]*domain.Message, len(found))
+	for _, m := range found {
+		messages[m.ID] = m
+	}
+	events := page.Events[:0]
+	for _, ev := range page.Events {
+		if ev.Kind == domain.SyncMessage {
+			if ev.Message = messages[ev.MessageID]; ev.Message == nil {
+				continue
+			}
+		}
+		events = append(events, ev)
+	}
+	page.Events = events
+	return page, nil
+}
+
+func (s *SyncStore) FindPosition(ctx context.Context, seq int64) (*repository.SyncPosition, error) {
+	var txid string
+	err := s.db.QueryRowContext(ctx, `SELECT txid::text FROM sync_events WHERE seq = $1`, seq).Scan(&txid)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	id, err := parseTxID(txid)
+	if err != nil {
+		return nil, err
+	}
+	return &repository.SyncPosition{TxID: id, Seq: seq}, nil
+}
+
+func (s *SyncStore) FindCursor(ctx context.Context, userID string, deviceID string) (repository.SyncPosition, error) {
+	var pos repository.SyncPosition
+	var txid string
+	q := `SELECT last_txid::text, last_seq FROM sync_cursors WHERE user_id = $1 AND device_id = $2`
+	err := s.db.QueryRowContext(ctx, q, userID, deviceID).Scan(&txid, &pos.Seq)
+	if errors.Is(err, sql.ErrNoRows) {
+		return pos, nil
+	}
+	if err != nil {
+		return pos, err
+	}
+	pos.TxID, err = parseTxID(txid)
+	return pos, err
+}
+
+func (s *SyncStore) AdvanceCursor(ctx context.Context, userID string, deviceID string, pos repository.SyncPosition) error {
+	q := `INSERT INTO sync_cursors (user_id, device_id, last_txid, last_seq, updated_at)
+	VALUES ($1, $2, $3::xid8, $4, NOW())
+	ON CONFLICT (user_id, device_id) DO UPDATE SET
+		last_txid = EXCLUDED.last_txid, last_seq = EXCLUDED.last_seq, updated_at = EXCLUDED.updated_at
+	WHERE (sync_cursors.last_txid, sync_cursors.last_seq) < (EXCLUDED.last_txid, EXCLUDED.last_seq)`
+	_, err := s.db.ExecContext(ctx, q, userID, deviceID, formatTxID(pos.TxID), pos.Seq)
+	return err
+}
+
+func (s *SyncStore) PrunedAfter(ctx context.Context, userID string, after repository.SyncPosition) (*repository.SyncPosition, error) {
+	q := `SELECT pm.last_txid::text, pm.last_seq FROM sync_prune_marks pm
+	JOIN chat_members cm ON cm.chat_id = pm.chat_id AND cm.user_id = $1 AND cm.membership_status = 'active'
+	WHERE (pm.last_txid, pm.last_seq) > ($2::xid8, $3)
+	AND pm.last_created_at >= cm.joined_at
+	ORDER BY pm.last_txid DESC, pm.last_seq DESC
+	LIMIT 1`
+	var txid string
+	var pos repository.SyncPosition
+	err := s.db.QueryRowContext(ctx, q, userID, formatTxID(after.TxID), after.Seq).Scan(&txid, &pos.Seq)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if pos.TxID, err = parseTxID(txid); err != nil {
+		return nil, err
+	}
+	return &pos, nil
+}
+
+func (s *SyncStore) Prune(ctx context.Context, before time.Time, limit int) (int, error) {
+	// With no cursors at all the row comparison is NULL, leaving only the age limit. Each
+	// chat's mark moves up to the newest event pruned from it, whichever rule removed it.
+	q := `WITH pruned AS (
+		DELETE FROM sync_events WHERE seq IN (
+			SELECT e.seq FROM sync_events e
+			WHERE e.created_at < $1
+			OR (e.txid, e.seq) <= (SELECT c.last_txid, c.last_seq FROM sync_cursors c ORDER BY c.last_txid, c.last_seq LIMIT 1)
+			ORDER BY e.seq
+			LIMIT $2)
+		RETURNING chat_id, txid, seq, created_at
+	), marked AS (
+		INSERT INTO sync_prune_marks (chat_id, last_txid, last_seq, last_created_at)
+		SELECT DISTINCT ON (chat_id) chat_id, txid, seq, created_at FROM pruned
+		ORDER BY chat_id, txid DESC, seq DESC
+		ON CONFLICT (chat_id) DO UPDATE SET
+			last_txid = EXCLUDED.last_txid, last_seq = EXCLUDED.last_seq, last_created_at = EXCLUDED.last_created_at
+		WHERE (sync_prune_marks.last_txid, sync_prune_marks.last_seq) < (EXCLUDED.last_txid, EXCLUDED.last_seq)
+	)
+	SELECT COUNT(*) FROM pruned`
+	var n int
+	err := s.db.QueryRowContext(ctx, q, before, limit).Scan(&n)
+	return n, err
+}
+
+// xid8 values travel as text; lib/pq has no native mapping for them.
+func formatTxID(id uint64) string { return strconv.FormatUint(id, 10) }
+
+func parseTxID(s string) (uint64, error) { return strconv.ParseUint(s, 10, 64) }
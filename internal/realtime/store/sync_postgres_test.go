@@ -0,0 +1,226 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	chatstore "github.com/dykethecreator/GoApp/internal/chat/store"
+	"github.com/dykethecreator/GoApp/internal/realtime/repository"
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/google/uuid"
+	_ "github.com/lib/pq"
+)
+
+// testDB opens the migrated database named by TEST_DATABASE_URL; without it the test is
+// skipped.
+func testDB(t *testing.T) *sql.DB {
+	t.Helper()
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL not set")
+	}
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func newSyncStore(db *sql.DB) repository.SyncRepository {
+	return NewSyncStore(db, chatstore.NewMessageStore(db, ""))
+}
+
+// newChatMember creates a user who is an active member of a new chat.
+func newChatMember(t *testing.T, db *sql.DB) (userID string, chatID string) {
+	t.Helper()
+	userID, chatID = uuid.NewString(), uuid.NewString()
+	phone := fmt.Sprintf("+1%010d", rand.Int63n(1e10))
+	stmts := []struct {
+		q    string
+		args []interface{}
+	}{
+		{`INSERT INTO users (id, phone_number) VALUES ($1, $2)`, []interface{}{userID, phone}},
+		{`INSERT INTO chats (id, type, created_by_user_id) VALUES ($1, 'group', $2)`, []interface{}{chatID, userID}},
+		{`INSERT INTO chat_members (chat_id, user_id, role, membership_status, joined_at)
+		VALUES ($1, $2, 'admin', 'active', NOW() - INTERVAL '1 minute')`, []interface{}{chatID, userID}},
+	}
+	for _, s := range stmts {
+		if _, err := db.Exec(s.q, s.args...); err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Cleanup(func() {
+		db.Exec(`DELETE FROM sync_events WHERE chat_id = $1`, chatID)
+		db.Exec(`DELETE FROM chat_members WHERE chat_id = $1`, chatID)
+		db.Exec(`DELETE FROM chats WHERE id = $1`, chatID)
+		db.Exec(`DELETE FROM users WHERE id = $1`, userID)
+	})
+	return userID, chatID
+}
+
+// execer is a *sql.DB or *sql.Tx.
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// logEdit appends an edit event for messageID of chatID through q.
+func logEdit(t *testing.T, q execer, chatID string, messageID int64) {
+	t.Helper()
+	_, err := q.ExecContext(context.Background(), `INSERT INTO sync_events (chat_id, kind, message_id) VALUES ($1, $2, $3)`, chatID, domain.SyncEdit, messageID)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// listUntil pages from after until the page holds want events or a few seconds pass;
+// transactions still running elsewhere in the database may hold events back for a moment.
+func listUntil(t *testing.T, store repository.SyncRepository, userID string, after repository.SyncPosition, want int) *repository.SyncPage {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		page, err := store.ListEvents(context.Background(), userID, after, 100)
+		if err != nil {
+			t.Fatalf("ListEvents: %v", err)
+		}
+		if len(page.Events) >= want || time.Now().After(deadline) {
+			return page
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+func messageIDs(page *repository.SyncPage) []int64 {
+	var ids []int64
+	for _, ev := range page.Events {
+		ids = append(ids, ev.MessageID)
+	}
+	return ids
+}
+
+// A transaction that started second but commits last must not land behind a position
+// already handed to a device.
+func TestSyncEventsLateCommitIsNotSkipped(t *testing.T) {
+	db := testDB(t)
+	ctx := context.Background()
+	userID, chatID := newChatMember(t, db)
+	store := newSyncStore(db)
+
+	slow, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer slow.Rollback()
+	if _, err := slow.ExecContext(ctx, `SELECT pg_current_xact_id()`); err != nil {
+		t.Fatal(err)
+	}
+
+	logEdit(t, db, chatID, 2) // commits at once, with a later txid
+
+	page, err := store.ListEvents(ctx, userID, repository.SyncPosition{}, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page.Events) != 0 {
+		t.Fatalf("got %v while an older transaction is still running, want nothing", messageIDs(page))
+	}
+
+	logEdit(t, slow, chatID, 1)
+	if err := slow.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	page = listUntil(t, store, userID, repository.SyncPosition{}, 2)
+	if got := messageIDs(page); len(got) != 2 || got[0] != 1 || got[1] != 2 {
+		t.Fatalf("got %v, want [1 2] in transaction order", got)
+	}
+
+	logEdit(t, db, chatID, 3)
+	next := listUntil(t, store, userID, page.Next, 1)
+	if got := messageIDs(next); len(got) != 1 || got[0] != 3 {
+		t.Errorf("page after %+v = %v, want [3]", page.Next, got)
+	}
+}
+
+func TestSyncEventsPaging(t *testing.T) {
+	db := testDB(t)
+	ctx := context.Background()
+	userID, chatID := newChatMember(t, db)
+	store := newSyncStore(db)
+	for i := int64(1); i <= 5; i++ {
+		logEdit(t, db, chatID, i)
+	}
+	listUntil(t, store, userID, repository.SyncPosition{}, 5)
+
+	var got []int64
+	pos := repository.SyncPosition{}
+	for {
+		page, err := store.ListEvents(ctx, userID, pos, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, messageIDs(page)...)
+		pos = page.Next
+		if !page.HasMore {
+			break
+		}
+	}
+	if fmt.Sprint(got) != "[1 2 3 4 5]" {
+		t.Errorf("paged through %v, want [1 2 3 4 5]", got)
+	}
+}
+
+func TestSyncEventsPrune(t *testing.T) {
+	db := testDB(t)
+	ctx := context.Background()
+	userID, chatID := newChatMember(t, db)
+	store := newSyncStore(db)
+	if _, err := db.Exec(`DELETE FROM sync_cursors`); err != nil {
+		t.Fatal(err)
+	}
+	for i := int64(1); i <= 3; i++ {
+		logEdit(t, db, chatID, i)
+	}
+	page := listUntil(t, store, userID, repository.SyncPosition{}, 3)
+	if len(page.Events) != 3 {
+		t.Fatalf("got %v, want 3 events", messageIDs(page))
+	}
+	if _, err := db.Exec(`UPDATE sync_events SET created_at = NOW() - INTERVAL '2 hours' WHERE chat_id = $1 AND message_id = 1`, chatID); err != nil {
+		t.Fatal(err)
+	}
+
+	// Without cursors only age counts.
+	if n, err := store.Prune(ctx, time.Now().Add(-time.Hour), 100); err != nil || n != 1 {
+		t.Fatalf("Prune = %d, %v; want the old event", n, err)
+	}
+
+	// A device that had not seen the pruned event has a gap; one past it does not.
+	second, err := store.FindPosition(ctx, page.Events[1].Seq)
+	if err != nil || second == nil {
+		t.Fatalf("FindPosition = %v, %v", second, err)
+	}
+	gap, err := store.PrunedAfter(ctx, userID, repository.SyncPosition{})
+	if err != nil || gap == nil || gap.Seq != page.Events[0].Seq {
+		t.Fatalf("PrunedAfter(start) = %+v, %v; want the pruned event %d", gap, err, page.Events[0].Seq)
+	}
+	if gap, err := store.PrunedAfter(ctx, userID, *gap); err != nil || gap != nil {
+		t.Fatalf("PrunedAfter(mark) = %+v, %v; want no gap", gap, err)
+	}
+
+	// Once the only device acknowledged the second event, it goes too.
+	if err := store.AdvanceCursor(ctx, userID, "phone", *second); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := store.Prune(ctx, time.Now().Add(-time.Hour), 100); err != nil || n != 1 {
+		t.Fatalf("Prune = %d, %v; want the acknowledged event", n, err)
+	}
+	left := listUntil(t, store, userID, repository.SyncPosition{}, 1)
+	if got := messageIDs(left); len(got) != 1 || got[0] != 3 {
+		t.Errorf("left %v, want [3]", got)
+	}
+}
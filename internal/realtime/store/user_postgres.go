@@ -0,0 +1,46 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/dykethecreator/GoApp/internal/realtime/repository"
+	"github.com/lib/pq"
+)
+
+// UserStore implements UserRepository for PostgreSQL.
+type UserStore struct {
+	db *sql.DB
+}
+
+func NewUserStore(db *sql.DB) repository.UserRepository {
+	return &UserStore{db: db}
+}
+
+func (s *UserStore) FindLastSeen(ctx context.Context, userIDs []string) (map[string]time.Time, error) {
+	out := map[string]time.Time{}
+	if len(userIDs) == 0 {
+		return out, nil
+	}
+	q := `SELECT id, last_seen_at FROM users WHERE id = ANY($1::uuid[]) AND last_seen_at IS NOT NULL`
+	rows, err := s.db.QueryContext(ctx, q, pq.Array(userIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id string
+		var at time.Time
+		if err := rows.Scan(&id, &at); err != nil {
+			return nil, err
+		}
+		out[id] = at
+	}
+	return out, rows.Err()
+}
+
+func (s *UserStore) UpdateLastSeen(ctx context.Context, userID string, at time.Time) error {
+	_, err := s.db.ExecContext(ctx, `UPDATE users SET last_seen_at = $2 WHERE id = $1`, userID, at)
+	return err
+}
@@ -0,0 +1,141 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/dykethecreator/GoApp/internal/realtime/repository"
+	"github.com/dykethecreator/GoApp/pkg/domain"
+)
+
+// handleSync answers a sync frame with the next page of changes the device missed.
+func (h *Hub) handleSync(c *Client, data json.RawMessage) {
+	var in SyncData
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &in); err != nil {
+			h.sendError(c, "malformed sync frame")
+			return
+		}
+	}
+	if !h.canSync(c) {
+		return
+	}
+	limit := in.Limit
+	if limit <= 0 || limit > h.cfg.SyncBatchSize {
+		limit = h.cfg.SyncBatchSize
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), h.cfg.LookupTimeout)
+	defer cancel()
+	var after repository.SyncPosition
+	if in.AfterSeq != nil && *in.AfterSeq > 0 {
+		pos, err := h.syncRepo.FindPosition(ctx, *in.AfterSeq)
+		if err != nil {
+			log.Printf("realtime: find sync position %d: %v", *in.AfterSeq, err)
+			return
+		}
+		if pos == nil {
+			// The position may have been pruned; the device can then only start over.
+			if h.resetIfPruned(ctx, c, repository.SyncPosition{}) {
+				return
+			}
+			h.sendError(c, "unknown sync position")
+			return
+		}
+		after = *pos
+	} else if in.AfterSeq == nil {
+		cursor, err := h.syncRepo.FindCursor(ctx, c.userID, c.deviceID)
+		if err != nil {
+			log.Printf("realtime: sync cursor of user=%s device=%s: %v", c.userID, c.deviceID, err)
+			return
+		}
+		after = cursor
+	}
+
+	if h.resetIfPruned(ctx, c, after) {
+		return
+	}
+
+	page, err := h.syncRepo.ListEvents(ctx, c.userID, after, limit)
+	if err != nil {
+		log.Printf("realtime: list sync events of user %s: %v", c.userID, err)
+		return
+	}
+	out := SyncBatchData{Events: page.Events, NextSeq: page.Next.Seq, HasMore: page.HasMore}
+	if out.Events == nil {
+		out.Events = []*domain.SyncEvent{}
+	}
+	frame, err := encodeFrameJSON(FrameSyncBatch, out)
+	if err != nil {
+		log.Printf("realtime: encode %s frame: %v", FrameSyncBatch, err)
+		return
+	}
+	c.enqueue(frame)
+}
+
+// resetIfPruned answers with a sync.reset frame when events the device would see after
+// after were pruned. The device's cursor moves past the gap so that, once it has reloaded
+// its chats, its next sync carries on from there. It reports false when the sync can go
+// ahead, and true when it was answered or, after a logged failure, must be dropped.
+func (h *Hub) resetIfPruned(ctx context.Context, c *Client, after repository.SyncPosition) bool {
+	gap, err := h.syncRepo.PrunedAfter(ctx, c.userID, after)
+	if err != nil {
+		log.Printf("realtime: pruned sync events of user %s: %v", c.userID, err)
+		return true
+	}
+	if gap == nil {
+		return false
+	}
+	if err := h.syncRepo.AdvanceCursor(ctx, c.userID, c.deviceID, *gap); err != nil {
+		log.Printf("realtime: advance sync cursor of user=%s device=%s: %v", c.userID, c.deviceID, err)
+		return true
+	}
+	frame, err := encodeFrame(FrameSyncReset, nil)
+	if err != nil {
+		log.Printf("realtime: encode %s frame: %v", FrameSyncReset, err)
+		return true
+	}
+	c.enqueue(frame)
+	return true
+}
+
+// handleSyncAck moves the device's cursor to the acknowledged position. Positions that
+// vanished with their chat are ignored; the device then simply sees some changes again.
+func (h *Hub) handleSyncAck(c *Client, data json.RawMessage) {
+	var in SyncAckData
+	if err := json.Unmarshal(data, &in); err != nil || in.Seq <= 0 {
+		h.sendError(c, "malformed sync.ack frame")
+		return
+	}
+	if !h.canSync(c) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), h.cfg.LookupTimeout)
+	defer cancel()
+	pos, err := h.syncRepo.FindPosition(ctx, in.Seq)
+	if err != nil {
+		log.Printf("realtime: find sync position %d: %v", in.Seq, err)
+		return
+	}
+	if pos == nil {
+		return
+	}
+	if err := h.syncRepo.AdvanceCursor(ctx, c.userID, c.deviceID, *pos); err != nil {
+		log.Printf("realtime: advance sync cursor of user=%s device=%s: %v", c.userID, c.deviceID, err)
+	}
+}
+
+// canSync reports whether c may use sync frames, answering with an error frame when not.
+func (h *Hub) canSync(c *Client) bool {
+	switch {
+	case h.syncRepo == nil:
+		h.sendError(c, "sync is not available")
+		return false
+	case c.ephemeral:
+		h.sendError(c, "sync requires a device_id")
+		return false
+	}
+	return true
+}
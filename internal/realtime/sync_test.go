@@ -0,0 +1,63 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/dykethecreator/GoApp/internal/realtime/repository"
+)
+
+// prunedLog is a SyncRepository whose log lost everything up to mark.
+type prunedLog struct {
+	repository.SyncRepository
+	mark   repository.SyncPosition
+	cursor repository.SyncPosition
+	listed int
+}
+
+func (r *prunedLog) FindCursor(_ context.Context, _ string, _ string) (repository.SyncPosition, error) {
+	return r.cursor, nil
+}
+
+func (r *prunedLog) AdvanceCursor(_ context.Context, _ string, _ string, pos repository.SyncPosition) error {
+	r.cursor = pos
+	return nil
+}
+
+func (r *prunedLog) PrunedAfter(_ context.Context, _ string, after repository.SyncPosition) (*repository.SyncPosition, error) {
+	if after.TxID < r.mark.TxID || (after.TxID == r.mark.TxID && after.Seq < r.mark.Seq) {
+		mark := r.mark
+		return &mark, nil
+	}
+	return nil, nil
+}
+
+func (r *prunedLog) ListEvents(_ context.Context, _ string, after repository.SyncPosition, _ int) (*repository.SyncPage, error) {
+	r.listed++
+	return &repository.SyncPage{Next: after}, nil
+}
+
+func TestSyncBehindPrunedEventsResets(t *testing.T) {
+	pruned := &prunedLog{mark: repository.SyncPosition{TxID: 10, Seq: 7}, cursor: repository.SyncPosition{TxID: 3, Seq: 2}}
+	hub := NewHub(Repositories{Sync: pruned}, DefaultConfig())
+	c := connect(hub, "alice")
+
+	hub.handleSync(c, nil)
+	if frames := framesOfType(t, c, FrameSyncReset, 50*time.Millisecond); len(frames) != 1 {
+		t.Fatalf("got %d sync.reset frames, want 1", len(frames))
+	}
+	if pruned.listed != 0 {
+		t.Error("listed events across the gap")
+	}
+	if pruned.cursor != pruned.mark {
+		t.Errorf("cursor = %+v, want it moved to the mark %+v", pruned.cursor, pruned.mark)
+	}
+
+	// After reloading, the device syncs from past the gap as usual.
+	hub.handleSync(c, json.RawMessage(`{}`))
+	if frames := framesOfType(t, c, FrameSyncBatch, 50*time.Millisecond); len(frames) != 1 {
+		t.Fatalf("got %d sync.batch frames after the reset, want 1", len(frames))
+	}
+}
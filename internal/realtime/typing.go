@@ -0,0 +1,194 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/google/uuid"
+)
+
+// typingKey identifies one user's typing notice in one chat.
+type typingKey struct {
+	chatID string
+	userID string
+}
+
+// typingState is an active typing or recording notice.
+type typingState struct {
+	action  string
+	members []string // recipients, loaded when the notice started
+	timer   *time.Timer
+}
+
+// handleTyping starts, refreshes or stops a member's typing notice and relays it to the
+// chat's other active members. A notice lapses after TypingTTL unless the client repeats it.
+// In a one-to-one chat where either side has blocked the other the notice is kept but
+// relayed to no one.
+func (h *Hub) handleTyping(c *Client, data json.RawMessage) {
+	var in TypingData
+	if err := json.Unmarshal(data, &in); err != nil || in.ChatID == "" {
+		h.sendError(c, "typing frame needs a chat_id")
+		return
+	}
+	if _, err := uuid.Parse(in.ChatID); err != nil {
+		h.sendError(c, "invalid chat id "+in.ChatID)
+		return
+	}
+	if in.Action == "" {
+		in.Action = TypingAction
+	}
+	switch in.Action {
+	case TypingAction, RecordingAction:
+	case PausedAction:
+		h.stopTyping(in.ChatID, c.userID)
+		return
+	default:
+		h.sendError(c, "unknown typing action "+in.Action)
+		return
+	}
+
+	key := typingKey{in.ChatID, c.userID}
+	h.typingMu.Lock()
+	st := h.typing[key]
+	h.typingMu.Unlock()
+
+	members := []string(nil)
+	if st != nil {
+		members = st.members
+	} else {
+		ctx, cancel := context.WithTimeout(context.Background(), h.cfg.LookupTimeout)
+		defer cancel()
+		ids, err := h.members.ListActiveMemberIDs(ctx, in.ChatID)
+		if err != nil {
+			log.Printf("realtime: load members of chat %s: %v", in.ChatID, err)
+			return
+		}
+		if !contains(ids, c.userID) {
+			h.sendError(c, "not a member of chat "+in.ChatID)
+			return
+		}
+		blocked, err := h.typingBlocked(ctx, in.ChatID, c.userID, ids)
+		if err != nil {
+			log.Printf("realtime: blocks in chat %s: %v", in.ChatID, err)
+			return
+		}
+		if !blocked {
+			members = ids
+		}
+	}
+
+	now := time.Now()
+	expires := now.Add(h.cfg.TypingTTL)
+	h.typingMu.Lock()
+	st = h.typing[key]
+	changed := st == nil || st.action != in.Action
+	if st == nil {
+		fresh := &typingState{members: members}
+		fresh.timer = time.AfterFunc(h.cfg.TypingTTL, func() { h.expireTyping(key, fresh) })
+		h.typing[key] = fresh
+		st = fresh
+	} else {
+		st.timer.Reset(h.cfg.TypingTTL)
+	}
+	st.action = in.Action
+	h.typingMu.Unlock()
+
+	// Repeats only keep the notice alive; members already know about it.
+	if changed {
+		h.sendTyping(members, TypingData{ChatID: in.ChatID, Action: in.Action, UserID: c.userID, Timestamp: now, ExpiresAt: &expires})
+	}
+}
+
+// typingBlocked reports whether userID's notice must not reach the other member of a
+// one-to-one chat because either of them has blocked the other.
+func (h *Hub) typingBlocked(ctx context.Context, chatID string, userID string, members []string) (bool, error) {
+	chatType, err := h.members.FindChatType(ctx, chatID)
+	if err != nil || chatType != domain.OneToOneChat {
+		return false, err
+	}
+	var others []string
+	for _, id := range members {
+		if id != userID {
+			others = append(others, id)
+		}
+	}
+	if len(others) == 0 {
+		return false, nil
+	}
+	views, err := h.privacyRepo.FindForViewer(ctx, others, userID)
+	if err != nil {
+		return false, err
+	}
+	for _, v := range views {
+		if v.Blocked {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// stopTyping ends the user's notice in the chat, telling the members it stopped.
+func (h *Hub) stopTyping(chatID string, userID string) {
+	key := typingKey{chatID, userID}
+	h.typingMu.Lock()
+	st := h.typing[key]
+	if st != nil {
+		st.timer.Stop()
+		delete(h.typing, key)
+	}
+	h.typingMu.Unlock()
+	if st != nil {
+		h.sendTyping(st.members, TypingData{ChatID: chatID, Action: PausedAction, UserID: userID, Timestamp: time.Now()})
+	}
+}
+
+// stopAllTyping ends every notice of the user, e.g. when their last device disconnects.
+func (h *Hub) stopAllTyping(userID string) {
+	h.typingMu.Lock()
+	var chats []string
+	for key := range h.typing {
+		if key.userID == userID {
+			chats = append(chats, key.chatID)
+		}
+	}
+	h.typingMu.Unlock()
+	for _, chatID := range chats {
+		h.stopTyping(chatID, userID)
+	}
+}
+
+// expireTyping ends a notice the client did not repeat in time, unless it was replaced.
+func (h *Hub) expireTyping(key typingKey, st *typingState) {
+	h.typingMu.Lock()
+	if h.typing[key] != st {
+		h.typingMu.Unlock()
+		return
+	}
+	delete(h.typing, key)
+	h.typingMu.Unlock()
+	h.sendTyping(st.members, TypingData{ChatID: key.chatID, Action: PausedAction, UserID: key.userID, Timestamp: time.Now()})
+}
+
+// clearTyping drops the user's notice in the chat without telling anyone, for when their
+// message arrived and clients stop showing the notice by themselves.
+func (h *Hub) clearTyping(chatID string, userID string) {
+	key := typingKey{chatID, userID}
+	h.typingMu.Lock()
+	if st := h.typing[key]; st != nil {
+		st.timer.Stop()
+		delete(h.typing, key)
+	}
+	h.typingMu.Unlock()
+}
+
+func (h *Hub) sendTyping(members []string, data TypingData) {
+	frame, err := encodeFrameJSON(FrameTyping, data)
+	if err != nil {
+		log.Printf("realtime: encode typing frame: %v", err)
+		return
+	}
+	h.SendToUsers(members, data.UserID, frame)
+}
@@ -0,0 +1,66 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/dykethecreator/GoApp/internal/realtime/repository"
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/google/uuid"
+)
+
+// chatTable is a MemberRepository over fixed chats.
+type chatTable struct {
+	types   map[string]domain.ChatType
+	members map[string][]string
+}
+
+func (t chatTable) ListActiveMemberIDs(_ context.Context, chatID string) ([]string, error) {
+	return t.members[chatID], nil
+}
+
+func (t chatTable) FindChatType(_ context.Context, chatID string) (domain.ChatType, error) {
+	return t.types[chatID], nil
+}
+
+func typingFrame(chatID string) json.RawMessage {
+	data, _ := json.Marshal(TypingData{ChatID: chatID, Action: TypingAction})
+	return data
+}
+
+func TestTypingSkipsBlockedDirectChat(t *testing.T) {
+	alice, bob := uuid.NewString(), uuid.NewString()
+	direct, group := uuid.NewString(), uuid.NewString()
+	chats := chatTable{
+		types:   map[string]domain.ChatType{direct: domain.OneToOneChat, group: domain.GroupChat},
+		members: map[string][]string{direct: {alice, bob}, group: {alice, bob}},
+	}
+	privacy := &privacyTable{views: map[string]*repository.PrivacyView{
+		bob: {Settings: domain.DefaultPrivacySettings(uuid.MustParse(bob)), Blocked: true},
+	}}
+	hub := NewHub(Repositories{Members: chats, Users: &lastSeenRecorder{lastSeen: map[string]time.Time{}}, Privacy: privacy}, DefaultConfig())
+	a := connect(hub, alice)
+	b := connect(hub, bob)
+
+	hub.handleTyping(a, typingFrame(direct))
+	if got := framesOfType(t, b, FrameTyping, 50*time.Millisecond); len(got) != 0 {
+		t.Fatalf("blocked direct chat relayed %d typing frames", len(got))
+	}
+	// Blocks only hide typing in one-to-one chats.
+	hub.handleTyping(a, typingFrame(group))
+	if got := framesOfType(t, b, FrameTyping, 50*time.Millisecond); len(got) != 1 {
+		t.Fatalf("group chat relayed %d typing frames, want 1", len(got))
+	}
+}
+
+func TestTypingRejectsInvalidChatID(t *testing.T) {
+	hub := NewHub(Repositories{Members: chatTable{}, Users: &lastSeenRecorder{lastSeen: map[string]time.Time{}}}, DefaultConfig())
+	a := connect(hub, uuid.NewString())
+
+	hub.handleTyping(a, typingFrame("not-a-uuid"))
+	if got := framesOfType(t, a, FrameError, 50*time.Millisecond); len(got) != 1 {
+		t.Fatalf("got %d error frames, want 1", len(got))
+	}
+}
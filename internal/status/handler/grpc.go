@@ -0,0 +1,195 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/dykethecreator/GoApp/internal/auth/middleware"
+	chatservice "github.com/dykethecreator/GoApp/internal/chat/service"
+	"github.com/dykethecreator/GoApp/internal/status/service"
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/dykethecreator/GoApp/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type StatusHandler struct {
+	proto.UnimplementedStatusServiceServer
+	service *service.StatusService
+}
+
+func NewStatusHandler(service *service.StatusService) *StatusHandler {
+	return &StatusHandler{service: service}
+}
+
+func (h *StatusHandler) Register(s *grpc.Server) {
+	proto.RegisterStatusServiceServer(s, h)
+}
+
+func (h *StatusHandler) CreateStatus(ctx context.Context, req *proto.CreateStatusRequest) (*proto.CreateStatusResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	in := service.CreateStatusInput{
+		ContentType: domain.StatusContentType(req.ContentType),
+		Content:     req.Content,
+		MediaURL:    req.MediaUrl,
+		TTL:         time.Duration(req.ExpiresInSeconds) * time.Second,
+	}
+	if req.Audience != "" || len(req.AudienceUserIds) > 0 {
+		in.Audience = &service.AudienceInput{Mode: domain.StatusAudienceMode(req.Audience), UserIDs: req.AudienceUserIds}
+	}
+	st, err := h.service.CreateStatus(ctx, userID, in)
+	if err != nil {
+		return nil, toStatus("create status", err)
+	}
+	return &proto.CreateStatusResponse{StatusId: st.ID.String(), Status: toProtoStatus(st)}, nil
+}
+
+func (h *StatusHandler) GetStatuses(ctx context.Context, req *proto.GetStatusesRequest) (*proto.GetStatusesResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	mine, groups, err := h.service.GetStatuses(ctx, userID)
+	if err != nil {
+		return nil, toStatus("get statuses", err)
+	}
+	resp := &proto.GetStatusesResponse{}
+	for _, st := range mine {
+		resp.MyStatuses = append(resp.MyStatuses, toProtoStatus(st))
+	}
+	for _, g := range groups {
+		pg := &proto.StatusGroup{UserId: g.UserID.String(), AllSeen: g.AllSeen()}
+		for _, st := range g.Statuses {
+			ps := toProtoStatus(st)
+			ps.Seen = g.Seen[st.ID]
+			pg.Statuses = append(pg.Statuses, ps)
+		}
+		pg.LastUpdatedAt = formatTime(g.Statuses[len(g.Statuses)-1].CreatedAt)
+		resp.Groups = append(resp.Groups, pg)
+	}
+	return resp, nil
+}
+
+func (h *StatusHandler) DeleteStatus(ctx context.Context, req *proto.DeleteStatusRequest) (*proto.DeleteStatusResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.service.DeleteStatus(ctx, userID, req.StatusId); err != nil {
+		return nil, toStatus("delete status", err)
+	}
+	return &proto.DeleteStatusResponse{Success: true}, nil
+}
+
+func (h *StatusHandler) MarkStatusViewed(ctx context.Context, req *proto.MarkStatusViewedRequest) (*proto.MarkStatusViewedResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.service.MarkStatusViewed(ctx, userID, req.StatusId); err != nil {
+		return nil, toStatus("mark status viewed", err)
+	}
+	return &proto.MarkStatusViewedResponse{Success: true}, nil
+}
+
+func (h *StatusHandler) GetStatusViewers(ctx context.Context, req *proto.GetStatusViewersRequest) (*proto.GetStatusViewersResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	views, err := h.service.GetStatusViewers(ctx, userID, req.StatusId)
+	if err != nil {
+		return nil, toStatus("get status viewers", err)
+	}
+	resp := &proto.GetStatusViewersResponse{}
+	for _, v := range views {
+		resp.Viewers = append(resp.Viewers, &proto.StatusViewer{UserId: v.UserID.String(), ViewedAt: formatTime(v.ViewedAt)})
+	}
+	return resp, nil
+}
+
+func (h *StatusHandler) ReplyToStatus(ctx context.Context, req *proto.ReplyToStatusRequest) (*proto.ReplyToStatusResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	msg, err := h.service.ReplyToStatus(ctx, userID, req.StatusId, req.Content, req.ClientMessageId)
+	if err != nil {
+		return nil, toStatus("reply to status", err)
+	}
+	return &proto.ReplyToStatusResponse{
+		ChatId:    msg.ChatID.String(),
+		MessageId: msg.ID,
+		CreatedAt: formatTime(msg.CreatedAt),
+	}, nil
+}
+
+// callerID returns the authenticated user injected by the auth interceptor.
+func callerID(ctx context.Context) (string, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || userID == "" {
+		return "", status.Error(codes.Unauthenticated, "missing authenticated user")
+	}
+	return userID, nil
+}
+
+// toStatus maps service errors to gRPC status codes; unknown errors become Internal.
+func toStatus(op string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidArgument):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrStatusNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrNotStatusOwner):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, service.ErrRepliesUnavailable):
+		return status.Error(codes.Unavailable, err.Error())
+	// Replies go through the chat service, whose refusals keep their meaning.
+	case errors.Is(err, chatservice.ErrInvalidArgument):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, chatservice.ErrBlocked):
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	log.Printf("%s failed: %v", op, err)
+	return status.Errorf(codes.Internal, "failed to %s", op)
+}
+
+func toProtoStatus(st *domain.StatusUpdate) *proto.StatusUpdate {
+	ps := &proto.StatusUpdate{
+		Id:          st.ID.String(),
+		UserId:      st.UserID.String(),
+		ContentType: string(st.ContentType),
+		CreatedAt:   formatTime(st.CreatedAt),
+		ExpiresAt:   formatTime(st.ExpiresAt),
+	}
+	if st.ContentType == domain.TextStatus {
+		ps.Content = st.ContentOrURL
+	} else {
+		ps.MediaUrl = st.ContentOrURL
+		if st.Caption != nil {
+			ps.Content = *st.Caption
+		}
+	}
+	if st.Audience != nil {
+		pa := &proto.StatusAudience{Mode: string(st.Audience.Mode)}
+		for _, id := range st.Audience.UserIDs {
+			pa.UserIds = append(pa.UserIds, id.String())
+		}
+		ps.Audience = pa
+	}
+	return ps
+}
+
+// formatTime renders timestamps as RFC3339 strings; zero times are empty.
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
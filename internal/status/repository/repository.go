@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/google/uuid"
+)
+
+// StatusRepository defines operations on status_updates.
+type StatusRepository interface {
+	// Create inserts the status with its audience (all contacts when nil) and fills in its
+	// ID and CreatedAt.
+	Create(ctx context.Context, st *domain.StatusUpdate) error
+	// FindByID returns the status, expired or not, or nil when it does not exist.
+	FindByID(ctx context.Context, statusID string) (*domain.StatusUpdate, error)
+	// ListByUser returns the user's statuses that are still active at at, oldest first, with
+	// their audiences.
+	ListByUser(ctx context.Context, userID string, at time.Time) ([]*domain.StatusUpdate, error)
+	// ListVisible returns the statuses active at at that viewerID may see: posted by users
+	// who have the viewer in their contacts and vice versa, whose audience includes the
+	// viewer, with no block in either direction. They are ordered by poster, then oldest first.
+	ListVisible(ctx context.Context, viewerID string, at time.Time) ([]*domain.StatusUpdate, error)
+	// CanView reports whether ListVisible would include the status for viewerID, ignoring expiry.
+	CanView(ctx context.Context, statusID string, viewerID string) (bool, error)
+	// FindStatusPrivacy returns the user's status privacy setting and its exception list,
+	// from which statuses posted without an explicit audience take theirs.
+	FindStatusPrivacy(ctx context.Context, userID string) (domain.PrivacyAudience, []uuid.UUID, error)
+	// Delete removes the status and its views; it returns false when there was nothing to delete.
+	Delete(ctx context.Context, statusID string) (bool, error)
+	// DeleteExpired removes up to limit statuses that expired at or before now, together
+	// with their views, and returns how many statuses were removed.
+	DeleteExpired(ctx context.Context, now time.Time, limit int) (int, error)
+}
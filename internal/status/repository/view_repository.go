@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/google/uuid"
+)
+
+// ViewRepository defines operations on status_views.
+type ViewRepository interface {
+	// RecordView stores that viewerID saw the status at at, unless they already had. A view
+	// by a user with read receipts turned off is stored hidden from the poster. It reports
+	// whether a new view was stored.
+	RecordView(ctx context.Context, statusID string, viewerID string, at time.Time) (bool, error)
+	// ListViewers returns the views of the status the poster may see, most recent first.
+	ListViewers(ctx context.Context, statusID string) ([]*domain.StatusView, error)
+	// ListViewed returns which of the statuses viewerID has viewed.
+	ListViewed(ctx context.Context, viewerID string, statusIDs []uuid.UUID) (map[uuid.UUID]bool, error)
+}
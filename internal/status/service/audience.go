@@ -0,0 +1,65 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/google/uuid"
+)
+
+// maxAudienceUsers bounds the list of an explicit status audience.
+const maxAudienceUsers = 1000
+
+// AudienceInput is the audience a client picks when posting a status.
+type AudienceInput struct {
+	Mode    domain.StatusAudienceMode
+	UserIDs []string // excluded contacts for AudienceAllContactsBut, chosen ones for AudienceOnlyShareWith
+}
+
+// resolveAudience validates the requested audience, or snapshots the poster's status
+// privacy setting when none was given, so later setting changes do not affect the status.
+func (s *StatusService) resolveAudience(ctx context.Context, owner uuid.UUID, in *AudienceInput) (*domain.StatusAudience, error) {
+	if in == nil {
+		return s.defaultAudience(ctx, owner)
+	}
+	if !in.Mode.Valid() {
+		return nil, fmt.Errorf("%w: unknown audience %q", ErrInvalidArgument, in.Mode)
+	}
+	if in.Mode == domain.AudienceAllContacts && len(in.UserIDs) > 0 {
+		return nil, fmt.Errorf("%w: audience %q takes no user ids", ErrInvalidArgument, in.Mode)
+	}
+	if len(in.UserIDs) > maxAudienceUsers {
+		return nil, fmt.Errorf("%w: at most %d audience user ids", ErrInvalidArgument, maxAudienceUsers)
+	}
+	audience := &domain.StatusAudience{Mode: in.Mode}
+	seen := map[uuid.UUID]bool{}
+	for _, raw := range in.UserIDs {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid audience user id %q", ErrInvalidArgument, raw)
+		}
+		if id == owner || seen[id] {
+			continue
+		}
+		seen[id] = true
+		audience.UserIDs = append(audience.UserIDs, id)
+	}
+	return audience, nil
+}
+
+// defaultAudience maps the poster's status privacy setting onto a status audience.
+func (s *StatusService) defaultAudience(ctx context.Context, owner uuid.UUID) (*domain.StatusAudience, error) {
+	setting, excluded, err := s.statusRepo.FindStatusPrivacy(ctx, owner.String())
+	if err != nil {
+		return nil, err
+	}
+	switch setting {
+	case domain.AudienceContactsExcept:
+		return &domain.StatusAudience{Mode: domain.AudienceAllContactsBut, UserIDs: excluded}, nil
+	case domain.AudienceNobody:
+		return &domain.StatusAudience{Mode: domain.AudienceOnlyShareWith}, nil
+	default:
+		return &domain.StatusAudience{Mode: domain.AudienceAllContacts}, nil
+	}
+}
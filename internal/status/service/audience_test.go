@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/dykethecreator/GoApp/internal/status/repository"
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/google/uuid"
+)
+
+// statusPrivacy is a StatusRepository that only knows the poster's status privacy setting.
+type statusPrivacy struct {
+	repository.StatusRepository
+	setting  domain.PrivacyAudience
+	excluded []uuid.UUID
+}
+
+func (r statusPrivacy) FindStatusPrivacy(_ context.Context, _ string) (domain.PrivacyAudience, []uuid.UUID, error) {
+	return r.setting, r.excluded, nil
+}
+
+func TestResolveAudience(t *testing.T) {
+	owner, a, b := uuid.New(), uuid.New(), uuid.New()
+	tests := []struct {
+		name    string
+		in      *AudienceInput
+		want    *domain.StatusAudience
+		wantErr bool
+	}{
+		{
+			name: "all contacts",
+			in:   &AudienceInput{Mode: domain.AudienceAllContacts},
+			want: &domain.StatusAudience{Mode: domain.AudienceAllContacts},
+		},
+		{
+			name: "owner and duplicates are dropped",
+			in:   &AudienceInput{Mode: domain.AudienceOnlyShareWith, UserIDs: []string{a.String(), owner.String(), b.String(), a.String()}},
+			want: &domain.StatusAudience{Mode: domain.AudienceOnlyShareWith, UserIDs: []uuid.UUID{a, b}},
+		},
+		{
+			name: "empty exception list",
+			in:   &AudienceInput{Mode: domain.AudienceAllContactsBut},
+			want: &domain.StatusAudience{Mode: domain.AudienceAllContactsBut},
+		},
+		{name: "unknown mode", in: &AudienceInput{Mode: "friends"}, wantErr: true},
+		{name: "all contacts with user ids", in: &AudienceInput{Mode: domain.AudienceAllContacts, UserIDs: []string{a.String()}}, wantErr: true},
+		{name: "invalid user id", in: &AudienceInput{Mode: domain.AudienceOnlyShareWith, UserIDs: []string{"bob"}}, wantErr: true},
+		{
+			name:    "too many user ids",
+			in:      &AudienceInput{Mode: domain.AudienceOnlyShareWith, UserIDs: strings.Split(strings.Repeat(a.String()+",", maxAudienceUsers), ",")},
+			wantErr: true,
+		},
+	}
+	s := &StatusService{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.resolveAudience(context.Background(), owner, tt.in)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidArgument) {
+					t.Fatalf("err = %v, want ErrInvalidArgument", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("resolveAudience: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("audience = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveAudienceDefaultsToStatusPrivacy(t *testing.T) {
+	owner, excluded := uuid.New(), uuid.New()
+	tests := []struct {
+		setting domain.PrivacyAudience
+		want    *domain.StatusAudience
+	}{
+		{domain.AudienceEveryone, &domain.StatusAudience{Mode: domain.AudienceAllContacts}},
+		{domain.AudienceContacts, &domain.StatusAudience{Mode: domain.AudienceAllContacts}},
+		{domain.AudienceContactsExcept, &domain.StatusAudience{Mode: domain.AudienceAllContactsBut, UserIDs: []uuid.UUID{excluded}}},
+		{domain.AudienceNobody, &domain.StatusAudience{Mode: domain.AudienceOnlyShareWith}},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.setting), func(t *testing.T) {
+			s := &StatusService{statusRepo: statusPrivacy{setting: tt.setting, excluded: []uuid.UUID{excluded}}}
+			got, err := s.resolveAudience(context.Background(), owner, nil)
+			if err != nil {
+				t.Fatalf("resolveAudience: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("audience = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,12 @@
+package service
+
+import "errors"
+
+// Errors returned by StatusService. The handler maps them to gRPC status codes.
+var (
+	ErrInvalidArgument = errors.New("invalid argument")
+	ErrStatusNotFound  = errors.New("status not found")
+	ErrNotStatusOwner  = errors.New("only the poster can do this")
+	// ErrRepliesUnavailable is returned by ReplyToStatus when no ChatSender is configured.
+	ErrRepliesUnavailable = errors.New("status replies are not available")
+)
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/dykethecreator/GoApp/pkg/domain"
+)
+
+// ChatSender posts messages through the chat service. It is satisfied by the chat
+// package's ChatService.
+type ChatSender interface {
+	// SendStatusReply posts a text reply into the one-to-one chat between the caller and
+	// the status poster, creating the chat when needed.
+	SendStatusReply(ctx context.Context, callerID string, preview *domain.StatusReplyPreview, content string, clientMessageID string) (*domain.Message, error)
+}
+
+// ReplyToStatus answers a status the caller may see with a chat message to its poster.
+// The message quotes the status so the reply still makes sense once the status expires.
+func (s *StatusService) ReplyToStatus(ctx context.Context, callerID string, statusID string, content string, clientMessageID string) (*domain.Message, error) {
+	if s.chats == nil {
+		return nil, ErrRepliesUnavailable
+	}
+	if strings.TrimSpace(content) == "" {
+		return nil, fmt.Errorf("%w: reply content is required", ErrInvalidArgument)
+	}
+	st, err := s.findStatus(ctx, statusID)
+	if err != nil {
+		return nil, err
+	}
+	if st.UserID.String() == callerID {
+		return nil, fmt.Errorf("%w: cannot reply to your own status", ErrInvalidArgument)
+	}
+	ok, err := s.statusRepo.CanView(ctx, statusID, callerID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrStatusNotFound
+	}
+	return s.chats.SendStatusReply(ctx, callerID, replyPreview(st), content, clientMessageID)
+}
+
+// replyPreview snapshots what a reply quotes of the status.
+func replyPreview(st *domain.StatusUpdate) *domain.StatusReplyPreview {
+	p := &domain.StatusReplyPreview{
+		StatusID:    st.ID,
+		UserID:      st.UserID,
+		ContentType: st.ContentType,
+		CreatedAt:   st.CreatedAt,
+	}
+	text := st.ContentOrURL
+	if st.ContentType != domain.TextStatus {
+		p.MediaURL = st.ContentOrURL
+		text = ""
+		if st.Caption != nil {
+			text = *st.Caption
+		}
+	}
+	p.Snippet = domain.QuoteSnippet(text)
+	return p
+}
@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/google/uuid"
+)
+
+// replySender is a ChatSender that remembers the previews it was asked to quote.
+type replySender struct {
+	previews []*domain.StatusReplyPreview
+}
+
+func (r *replySender) SendStatusReply(_ context.Context, _ string, preview *domain.StatusReplyPreview, content string, _ string) (*domain.Message, error) {
+	r.previews = append(r.previews, preview)
+	return &domain.Message{Content: content}, nil
+}
+
+func TestReplyPreview(t *testing.T) {
+	caption := "  sunset\n at the   beach "
+	tests := []struct {
+		name      string
+		st        *domain.StatusUpdate
+		snippet   string
+		wantMedia bool
+	}{
+		{"text collapses whitespace", &domain.StatusUpdate{ContentType: domain.TextStatus, ContentOrURL: "good\n\nmorning  all"}, "good morning all", false},
+		{"text is cut after the limit", &domain.StatusUpdate{ContentType: domain.TextStatus, ContentOrURL: strings.Repeat("ş", domain.MaxQuoteRunes+5)}, strings.Repeat("ş", domain.MaxQuoteRunes) + "…", false},
+		{"image quotes its caption", &domain.StatusUpdate{ContentType: domain.ImageStatus, ContentOrURL: "https://cdn/1.jpg", Caption: &caption}, "sunset at the beach", true},
+		{"video without caption", &domain.StatusUpdate{ContentType: domain.VideoStatus, ContentOrURL: "https://cdn/1.mp4"}, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := replyPreview(tt.st)
+			if p.Snippet != tt.snippet {
+				t.Errorf("snippet = %q, want %q", p.Snippet, tt.snippet)
+			}
+			if got := p.MediaURL != ""; got != tt.wantMedia {
+				t.Errorf("media url = %q, want media %v", p.MediaURL, tt.wantMedia)
+			}
+		})
+	}
+}
+
+func TestReplyToStatus(t *testing.T) {
+	poster, viewer := uuid.New(), uuid.New()
+	shared := &domain.StatusUpdate{ID: uuid.New(), UserID: poster, ContentType: domain.TextStatus, ContentOrURL: "hi", ExpiresAt: time.Now().Add(time.Hour)}
+	hidden := &domain.StatusUpdate{ID: uuid.New(), UserID: poster, ContentType: domain.TextStatus, ContentOrURL: "hi", ExpiresAt: time.Now().Add(time.Hour)}
+	statuses := &statusTable{
+		statuses: map[string]*domain.StatusUpdate{shared.ID.String(): shared, hidden.ID.String(): hidden},
+		viewable: map[string]bool{shared.ID.String(): true},
+	}
+	tests := []struct {
+		name    string
+		caller  uuid.UUID
+		status  string
+		content string
+		wantErr error
+	}{
+		{name: "viewer in the audience", caller: viewer, status: shared.ID.String(), content: "nice"},
+		{name: "viewer outside the audience", caller: viewer, status: hidden.ID.String(), content: "nice", wantErr: ErrStatusNotFound},
+		{name: "poster replying to their own", caller: poster, status: shared.ID.String(), content: "nice", wantErr: ErrInvalidArgument},
+		{name: "blank reply", caller: viewer, status: shared.ID.String(), content: " ", wantErr: ErrInvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chats := &replySender{}
+			s := NewStatusService(Repositories{Statuses: statuses}, chats, DefaultConfig())
+			_, err := s.ReplyToStatus(context.Background(), tt.caller.String(), tt.status, tt.content, "")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if sent := len(chats.previews) == 1; sent != (tt.wantErr == nil) {
+				t.Fatalf("reply sent = %v", sent)
+			}
+			if tt.wantErr == nil && chats.previews[0].StatusID != shared.ID {
+				t.Errorf("reply quotes status %s, want %s", chats.previews[0].StatusID, shared.ID)
+			}
+		})
+	}
+}
+
+func TestReplyToStatusWithoutChats(t *testing.T) {
+	s := NewStatusService(Repositories{Statuses: &statusTable{}}, nil, DefaultConfig())
+	if _, err := s.ReplyToStatus(context.Background(), uuid.NewString(), uuid.NewString(), "hi", ""); !errors.Is(err, ErrRepliesUnavailable) {
+		t.Fatalf("err = %v, want ErrRepliesUnavailable", err)
+	}
+}
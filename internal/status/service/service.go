@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dykethecreator/GoApp/internal/status/repository"
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/google/uuid"
+)
+
+// maxCaptionRunes bounds the caption of image and video statuses.
+const maxCaptionRunes = 700
+
+// Config holds the tunable limits of the status service.
+type Config struct {
+	// DefaultTTL is how long a status stays visible when the client does not ask otherwise.
+	DefaultTTL time.Duration
+	// MaxTTL is the longest lifetime a client may ask for.
+	MaxTTL time.Duration
+}
+
+// DefaultConfig returns the limits used when nothing is configured.
+func DefaultConfig() Config {
+	return Config{
+		DefaultTTL: 24 * time.Hour,
+		MaxTTL:     24 * time.Hour,
+	}
+}
+
+// Repositories groups the data-layer dependencies of StatusService.
+type Repositories struct {
+	Statuses repository.StatusRepository
+	Views    repository.ViewRepository
+}
+
+type StatusService struct {
+	statusRepo repository.StatusRepository
+	viewRepo   repository.ViewRepository
+	chats      ChatSender
+	cfg        Config
+}
+
+// NewStatusService creates a StatusService. chats may be nil, in which case ReplyToStatus
+// fails with ErrRepliesUnavailable.
+func NewStatusService(repos Repositories, chats ChatSender, cfg Config) *StatusService {
+	return &StatusService{statusRepo: repos.Statuses, viewRepo: repos.Views, chats: chats, cfg: cfg}
+}
+
+// CreateStatusInput carries the fields a client may set when posting a status.
+type CreateStatusInput struct {
+	ContentType domain.StatusContentType
+	Content     string // the text of text statuses, the caption of image and video statuses
+	MediaURL    string
+	TTL         time.Duration // 0 means Config.DefaultTTL
+	// Audience picks who may see the status; nil derives it from the caller's status
+	// privacy setting.
+	Audience *AudienceInput
+}
+
+// StatusGroup is one poster's active statuses, oldest first.
+type StatusGroup struct {
+	UserID   uuid.UUID
+	Statuses []*domain.StatusUpdate
+	Seen     map[uuid.UUID]bool // the statuses the caller has viewed
+}
+
+// CreateStatus posts a status for the caller that expires after the requested TTL.
+func (s *StatusService) CreateStatus(ctx context.Context, callerID string, in CreateStatusInput) (*domain.StatusUpdate, error) {
+	owner, err := uuid.Parse(callerID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid caller id", ErrInvalidArgument)
+	}
+	ttl := in.TTL
+	if ttl == 0 {
+		ttl = s.cfg.DefaultTTL
+	}
+	if ttl < 0 || ttl > s.cfg.MaxTTL {
+		return nil, fmt.Errorf("%w: a status may last at most %s", ErrInvalidArgument, s.cfg.MaxTTL)
+	}
+
+	st := &domain.StatusUpdate{UserID: owner, ContentType: in.ContentType, ExpiresAt: time.Now().Add(ttl)}
+	switch in.ContentType {
+	case domain.TextStatus:
+		if strings.TrimSpace(in.Content) == "" {
+			return nil, fmt.Errorf("%w: text status content is required", ErrInvalidArgument)
+		}
+		if in.MediaURL != "" {
+			return nil, fmt.Errorf("%w: text statuses cannot carry media", ErrInvalidArgument)
+		}
+		st.ContentOrURL = in.Content
+	case domain.ImageStatus, domain.VideoStatus:
+		if in.MediaURL == "" {
+			return nil, fmt.Errorf("%w: media_url is required for %s statuses", ErrInvalidArgument, in.ContentType)
+		}
+		if len([]rune(in.Content)) > maxCaptionRunes {
+			return nil, fmt.Errorf("%w: caption longer than %d characters", ErrInvalidArgument, maxCaptionRunes)
+		}
+		st.ContentOrURL = in.MediaURL
+		if in.Content != "" {
+			st.Caption = &in.Content
+		}
+	default:
+		return nil, fmt.Errorf("%w: unsupported content type %q", ErrInvalidArgument, in.ContentType)
+	}
+	if st.Audience, err = s.resolveAudience(ctx, owner, in.Audience); err != nil {
+		return nil, err
+	}
+
+	if err := s.statusRepo.Create(ctx, st); err != nil {
+		return nil, err
+	}
+	return st, nil
+}
+
+// GetStatuses returns the caller's own active statuses and those of their contacts that
+// the caller may see, grouped by poster. Posters with statuses the caller has not viewed
+// yet come first; within each part the most recently updated poster leads.
+func (s *StatusService) GetStatuses(ctx context.Context, callerID string) (mine []*domain.StatusUpdate, groups []*StatusGroup, err error) {
+	now := time.Now()
+	mine, err = s.statusRepo.ListByUser(ctx, callerID, now)
+	if err != nil {
+		return nil, nil, err
+	}
+	visible, err := s.statusRepo.ListVisible(ctx, callerID, now)
+	if err != nil {
+		return nil, nil, err
+	}
+	ids := make([]uuid.UUID, 0, len(visible))
+	for _, st := range visible {
+		ids = append(ids, st.ID)
+	}
+	seen, err := s.viewRepo.ListViewed(ctx, callerID, ids)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, st := range visible {
+		if n := len(groups); n == 0 || groups[n-1].UserID != st.UserID {
+			groups = append(groups, &StatusGroup{UserID: st.UserID, Seen: map[uuid.UUID]bool{}})
+		}
+		g := groups[len(groups)-1]
+		g.Statuses = append(g.Statuses, st)
+		if seen[st.ID] {
+			g.Seen[st.ID] = true
+		}
+	}
+	sort.SliceStable(groups, func(i, j int) bool {
+		if a, b := groups[i].AllSeen(), groups[j].AllSeen(); a != b {
+			return b
+		}
+		return groups[i].latest().After(groups[j].latest())
+	})
+	return mine, groups, nil
+}
+
+// DeleteStatus removes one of the caller's statuses before it expires.
+func (s *StatusService) DeleteStatus(ctx context.Context, callerID string, statusID string) error {
+	st, err := s.findStatus(ctx, statusID)
+	if err != nil {
+		return err
+	}
+	if st.UserID.String() != callerID {
+		return ErrNotStatusOwner
+	}
+	if _, err := s.statusRepo.Delete(ctx, statusID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// findStatus loads a status that has not expired yet.
+func (s *StatusService) findStatus(ctx context.Context, statusID string) (*domain.StatusUpdate, error) {
+	if _, err := uuid.Parse(statusID); err != nil {
+		return nil, fmt.Errorf("%w: invalid status id", ErrInvalidArgument)
+	}
+	st, err := s.statusRepo.FindByID(ctx, statusID)
+	if err != nil {
+		return nil, err
+	}
+	if st == nil || !st.ExpiresAt.After(time.Now()) {
+		return nil, ErrStatusNotFound
+	}
+	return st, nil
+}
+
+// AllSeen reports whether the caller has viewed every status in the group.
+func (g *StatusGroup) AllSeen() bool {
+	return len(g.Seen) == len(g.Statuses)
+}
+
+// latest returns when the group's newest status was posted.
+func (g *StatusGroup) latest() time.Time {
+	return g.Statuses[len(g.Statuses)-1].CreatedAt
+}
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dykethecreator/GoApp/internal/status/repository"
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/google/uuid"
+)
+
+// statusTable is a StatusRepository over fixed statuses.
+type statusTable struct {
+	repository.StatusRepository
+	statuses map[string]*domain.StatusUpdate
+	visible  []*domain.StatusUpdate // what ListVisible returns
+	viewable map[string]bool        // the status IDs CanView allows
+	created  []*domain.StatusUpdate
+}
+
+func (r *statusTable) Create(_ context.Context, st *domain.StatusUpdate) error {
+	st.ID = uuid.New()
+	st.CreatedAt = time.Now()
+	r.created = append(r.created, st)
+	return nil
+}
+
+func (r *statusTable) FindByID(_ context.Context, statusID string) (*domain.StatusUpdate, error) {
+	return r.statuses[statusID], nil
+}
+
+func (r *statusTable) ListByUser(_ context.Context, _ string, _ time.Time) ([]*domain.StatusUpdate, error) {
+	return nil, nil
+}
+
+func (r *statusTable) ListVisible(_ context.Context, _ string, _ time.Time) ([]*domain.StatusUpdate, error) {
+	return r.visible, nil
+}
+
+func (r *statusTable) CanView(_ context.Context, statusID string, _ string) (bool, error) {
+	return r.viewable[statusID], nil
+}
+
+func (r *statusTable) FindStatusPrivacy(_ context.Context, _ string) (domain.PrivacyAudience, []uuid.UUID, error) {
+	return domain.AudienceContacts, nil, nil
+}
+
+// viewLog is a ViewRepository that records views in memory.
+type viewLog struct {
+	repository.ViewRepository
+	viewed   map[uuid.UUID]bool
+	recorded []string
+}
+
+func (r *viewLog) RecordView(_ context.Context, statusID string, _ string, _ time.Time) (bool, error) {
+	r.recorded = append(r.recorded, statusID)
+	return true, nil
+}
+
+func (r *viewLog) ListViewers(_ context.Context, _ string) ([]*domain.StatusView, error) {
+	return nil, nil
+}
+
+func (r *viewLog) ListViewed(_ context.Context, _ string, _ []uuid.UUID) (map[uuid.UUID]bool, error) {
+	return r.viewed, nil
+}
+
+func TestCreateStatus(t *testing.T) {
+	caller := uuid.NewString()
+	long := strings.Repeat("ş", maxCaptionRunes+1)
+	tests := []struct {
+		name    string
+		in      CreateStatusInput
+		wantErr bool
+	}{
+		{name: "text", in: CreateStatusInput{ContentType: domain.TextStatus, Content: "hello"}},
+		{name: "image with caption", in: CreateStatusInput{ContentType: domain.ImageStatus, MediaURL: "https://cdn/1.jpg", Content: "beach"}},
+		{name: "video without caption", in: CreateStatusInput{ContentType: domain.VideoStatus, MediaURL: "https://cdn/1.mp4"}},
+		{name: "shorter ttl", in: CreateStatusInput{ContentType: domain.TextStatus, Content: "hi", TTL: time.Hour}},
+		{name: "blank text", in: CreateStatusInput{ContentType: domain.TextStatus, Content: " "}, wantErr: true},
+		{name: "text with media", in: CreateStatusInput{ContentType: domain.TextStatus, Content: "hi", MediaURL: "https://cdn/1.jpg"}, wantErr: true},
+		{name: "image without media", in: CreateStatusInput{ContentType: domain.ImageStatus, Content: "beach"}, wantErr: true},
+		{name: "long caption", in: CreateStatusInput{ContentType: domain.ImageStatus, MediaURL: "https://cdn/1.jpg", Content: long}, wantErr: true},
+		{name: "unknown content type", in: CreateStatusInput{ContentType: "audio", MediaURL: "https://cdn/1.ogg"}, wantErr: true},
+		{name: "ttl above the maximum", in: CreateStatusInput{ContentType: domain.TextStatus, Content: "hi", TTL: 25 * time.Hour}, wantErr: true},
+		{name: "negative ttl", in: CreateStatusInput{ContentType: domain.TextStatus, Content: "hi", TTL: -time.Hour}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statuses := &statusTable{}
+			s := NewStatusService(Repositories{Statuses: statuses}, nil, DefaultConfig())
+			st, err := s.CreateStatus(context.Background(), caller, tt.in)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidArgument) {
+					t.Fatalf("err = %v, want ErrInvalidArgument", err)
+				}
+				if len(statuses.created) != 0 {
+					t.Error("an invalid status was stored")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CreateStatus: %v", err)
+			}
+			ttl := tt.in.TTL
+			if ttl == 0 {
+				ttl = DefaultConfig().DefaultTTL
+			}
+			if d := st.ExpiresAt.Sub(st.CreatedAt); d < ttl-time.Minute || d > ttl {
+				t.Errorf("status lasts %s, want %s", d, ttl)
+			}
+			if st.Audience == nil || st.Audience.Mode != domain.AudienceAllContacts {
+				t.Errorf("audience = %+v, want all contacts", st.Audience)
+			}
+		})
+	}
+}
+
+func TestGetStatusesOrdersUnseenFirst(t *testing.T) {
+	now := time.Now()
+	post := func(userID uuid.UUID, age time.Duration) *domain.StatusUpdate {
+		return &domain.StatusUpdate{ID: uuid.New(), UserID: userID, CreatedAt: now.Add(-age)}
+	}
+	seenPoster, oldPoster, newPoster := uuid.New(), uuid.New(), uuid.New()
+	seen := post(seenPoster, time.Minute)
+	oldFirst, oldSecond := post(oldPoster, 3*time.Hour), post(oldPoster, 2*time.Hour)
+	fresh := post(newPoster, time.Hour)
+	statuses := &statusTable{visible: []*domain.StatusUpdate{seen, oldFirst, oldSecond, fresh}}
+	views := &viewLog{viewed: map[uuid.UUID]bool{seen.ID: true, oldFirst.ID: true}}
+	s := NewStatusService(Repositories{Statuses: statuses, Views: views}, nil, DefaultConfig())
+
+	_, groups, err := s.GetStatuses(context.Background(), uuid.NewString())
+	if err != nil {
+		t.Fatalf("GetStatuses: %v", err)
+	}
+	var order []uuid.UUID
+	for _, g := range groups {
+		order = append(order, g.UserID)
+	}
+	// Unseen groups lead, the most recently updated first; fully seen groups follow.
+	want := []uuid.UUID{newPoster, oldPoster, seenPoster}
+	if !slices.Equal(order, want) {
+		t.Fatalf("poster order = %v, want %v", order, want)
+	}
+	if g := groups[1]; len(g.Statuses) != 2 || g.AllSeen() || !g.Seen[oldFirst.ID] {
+		t.Errorf("group of %s = %+v, want two statuses with only the first seen", oldPoster, g)
+	}
+}
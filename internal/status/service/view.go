@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/dykethecreator/GoApp/pkg/domain"
+)
+
+// MarkStatusViewed records that the caller viewed a status they may see. Viewing the same
+// status again, or one of your own, changes nothing. Views by users who turned read
+// receipts off count for their own seen flags but are not shown to the poster.
+func (s *StatusService) MarkStatusViewed(ctx context.Context, callerID string, statusID string) error {
+	st, err := s.findStatus(ctx, statusID)
+	if err != nil {
+		return err
+	}
+	if st.UserID.String() == callerID {
+		return nil
+	}
+	ok, err := s.statusRepo.CanView(ctx, statusID, callerID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrStatusNotFound
+	}
+	_, err = s.viewRepo.RecordView(ctx, statusID, callerID, time.Now())
+	return err
+}
+
+// GetStatusViewers lists who viewed one of the caller's statuses, most recent first.
+func (s *StatusService) GetStatusViewers(ctx context.Context, callerID string, statusID string) ([]*domain.StatusView, error) {
+	st, err := s.findStatus(ctx, statusID)
+	if err != nil {
+		return nil, err
+	}
+	if st.UserID.String() != callerID {
+		return nil, ErrNotStatusOwner
+	}
+	return s.viewRepo.ListViewers(ctx, statusID)
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/google/uuid"
+)
+
+func TestMarkStatusViewed(t *testing.T) {
+	poster, viewer := uuid.New(), uuid.New()
+	active := &domain.StatusUpdate{ID: uuid.New(), UserID: poster, ExpiresAt: time.Now().Add(time.Hour)}
+	hidden := &domain.StatusUpdate{ID: uuid.New(), UserID: poster, ExpiresAt: time.Now().Add(time.Hour)}
+	expired := &domain.StatusUpdate{ID: uuid.New(), UserID: poster, ExpiresAt: time.Now().Add(-time.Minute)}
+	statuses := &statusTable{
+		statuses: map[string]*domain.StatusUpdate{active.ID.String(): active, hidden.ID.String(): hidden, expired.ID.String(): expired},
+		viewable: map[string]bool{active.ID.String(): true, expired.ID.String(): true},
+	}
+	tests := []struct {
+		name     string
+		caller   uuid.UUID
+		status   string
+		wantErr  error
+		recorded bool
+	}{
+		{name: "viewer in the audience", caller: viewer, status: active.ID.String(), recorded: true},
+		{name: "poster viewing their own", caller: poster, status: active.ID.String()},
+		{name: "viewer outside the audience", caller: viewer, status: hidden.ID.String(), wantErr: ErrStatusNotFound},
+		{name: "expired status", caller: viewer, status: expired.ID.String(), wantErr: ErrStatusNotFound},
+		{name: "unknown status", caller: viewer, status: uuid.NewString(), wantErr: ErrStatusNotFound},
+		{name: "invalid status id", caller: viewer, status: "status-1", wantErr: ErrInvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			views := &viewLog{}
+			s := NewStatusService(Repositories{Statuses: statuses, Views: views}, nil, DefaultConfig())
+			err := s.MarkStatusViewed(context.Background(), tt.caller.String(), tt.status)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if got := len(views.recorded) == 1; got != tt.recorded {
+				t.Errorf("view recorded = %v, want %v", got, tt.recorded)
+			}
+		})
+	}
+}
+
+func TestGetStatusViewersIsPosterOnly(t *testing.T) {
+	poster := uuid.New()
+	st := &domain.StatusUpdate{ID: uuid.New(), UserID: poster, ExpiresAt: time.Now().Add(time.Hour)}
+	statuses := &statusTable{
+		statuses: map[string]*domain.StatusUpdate{st.ID.String(): st},
+		viewable: map[string]bool{st.ID.String(): true},
+	}
+	s := NewStatusService(Repositories{Statuses: statuses, Views: &viewLog{}}, nil, DefaultConfig())
+
+	if _, err := s.GetStatusViewers(context.Background(), poster.String(), st.ID.String()); err != nil {
+		t.Fatalf("GetStatusViewers by the poster: %v", err)
+	}
+	if _, err := s.GetStatusViewers(context.Background(), uuid.NewString(), st.ID.String()); !errors.Is(err, ErrNotStatusOwner) {
+		t.Fatalf("GetStatusViewers by a viewer: err = %v, want ErrNotStatusOwner", err)
+	}
+}
@@ -0,0 +1,286 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/dykethecreator/GoApp/internal/outbox"
+	"github.com/dykethecreator/GoApp/internal/status/repository"
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/dykethecreator/GoApp/pkg/eventbus"
+	pb "github.com/dykethecreator/GoApp/proto"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// outboxProducer names the status service as the producer of the events it records.
+const outboxProducer = "status_service"
+
+// StatusStore implements StatusRepository for PostgreSQL.
+type StatusStore struct {
+	db *sql.DB
+}
+
+func NewStatusStore(db *sql.DB) repository.StatusRepository {
+	return &StatusStore{db: db}
+}
+
+// statusColumns lists the status_updates columns in the order scanStatus reads them.
+const statusColumns = `s.id, s.user_id, s.content_type, COALESCE(s.content_or_url, ''), s.caption, s.created_at, s.expires_at`
+
+func scanStatus(row interface{ Scan(dest ...any) error }) (*domain.StatusUpdate, error) {
+	var st domain.StatusUpdate
+	if err := row.Scan(&st.ID, &st.UserID, &st.ContentType, &st.ContentOrURL, &st.Caption, &st.CreatedAt, &st.ExpiresAt); err != nil {
+		return nil, err
+	}
+	return &st, nil
+}
+
+func scanStatuses(rows *sql.Rows) ([]*domain.StatusUpdate, error) {
+	defer rows.Close()
+	var out []*domain.StatusUpdate
+	for rows.Next() {
+		st, err := scanStatus(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, st)
+	}
+	return out, rows.Err()
+}
+
+func (s *StatusStore) Create(ctx context.Context, st *domain.StatusUpdate) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	q := `INSERT INTO status_updates (user_id, content_type, content_or_url, caption, expires_at)
+	VALUES ($1, $2, $3, $4, $5)
+	RETURNING id, created_at`
+	if err := tx.QueryRowContext(ctx, q, st.UserID, st.ContentType, st.ContentOrURL, st.Caption, st.ExpiresAt).Scan(&st.ID, &st.CreatedAt); err != nil {
+		return err
+	}
+
+	audience := st.Audience
+	if audience == nil {
+		audience = &domain.StatusAudience{Mode: domain.AudienceAllContacts}
+	}
+	if _, err := tx.ExecContext(ctx, `INSERT INTO status_audiences (status_id, mode) VALUES ($1, $2)`, st.ID, audience.Mode); err != nil {
+		return err
+	}
+	if len(audience.UserIDs) > 0 {
+		ids := make([]string, 0, len(audience.UserIDs))
+		for _, id := range audience.UserIDs {
+			ids = append(ids, id.String())
+		}
+		mq := `INSERT INTO status_audience_members (status_id, user_id)
+		SELECT $1, unnest($2::uuid[]) ON CONFLICT DO NOTHING`
+		if _, err := tx.ExecContext(ctx, mq, st.ID, pq.Array(ids)); err != nil {
+			return err
+		}
+	}
+
+	ev := &pb.StatusPosted{
+		StatusId:    st.ID.String(),
+		UserId:      st.UserID.String(),
+		ContentType: string(st.ContentType),
+		CreatedAt:   st.CreatedAt.UTC().Format(time.RFC3339Nano),
+		ExpiresAt:   st.ExpiresAt.UTC().Format(time.RFC3339Nano),
+	}
+	if err := outbox.EnqueueEvent(ctx, tx, eventbus.TopicStatusEvents, ev.UserId, outboxProducer, ev, st.CreatedAt); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (s *StatusStore) FindByID(ctx context.Context, statusID string) (*domain.StatusUpdate, error) {
+	q := `SELECT ` + statusColumns + ` FROM status_updates s WHERE s.id = $1`
+	st, err := scanStatus(s.db.QueryRowContext(ctx, q, statusID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return st, err
+}
+
+// ListByUser also loads each status's audience, since only the poster sees it.
+func (s *StatusStore) ListByUser(ctx context.Context, userID string, at time.Time) ([]*domain.StatusUpdate, error) {
+	q := `SELECT ` + statusColumns + `, COALESCE(a.mode, 'contacts') FROM status_updates s
+	LEFT JOIN status_audiences a ON a.status_id = s.id
+	WHERE s.user_id = $1 AND s.expires_at > $2
+	ORDER BY s.created_at ASC, s.id ASC`
+	rows, err := s.db.QueryContext(ctx, q, userID, at)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []*domain.StatusUpdate
+	byID := map[uuid.UUID]*domain.StatusUpdate{}
+	for rows.Next() {
+		var st domain.StatusUpdate
+		var mode string
+		if err := rows.Scan(&st.ID, &st.UserID, &st.ContentType, &st.ContentOrURL, &st.Caption, &st.CreatedAt, &st.ExpiresAt, &mode); err != nil {
+			return nil, err
+		}
+		st.Audience = &domain.StatusAudience{Mode: domain.StatusAudienceMode(mode)}
+		out = append(out, &st)
+		byID[st.ID] = &st
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(out) == 0 {
+		return out, nil
+	}
+
+	mq := `SELECT m.status_id, m.user_id FROM status_audience_members m
+	JOIN status_updates s ON s.id = m.status_id
+	WHERE s.user_id = $1 AND s.expires_at > $2`
+	mrows, err := s.db.QueryContext(ctx, mq, userID, at)
+	if err != nil {
+		return nil, err
+	}
+	defer mrows.Close()
+	for mrows.Next() {
+		var statusID, memberID uuid.UUID
+		if err := mrows.Scan(&statusID, &memberID); err != nil {
+			return nil, err
+		}
+		if st := byID[statusID]; st != nil {
+			st.Audience.UserIDs = append(st.Audience.UserIDs, memberID)
+		}
+	}
+	return out, mrows.Err()
+}
+
+// visibleToViewer restricts the status s to the viewer in $1: each has the other in their
+// contacts, the status's audience includes the viewer and neither has blocked the other.
+// Statuses without an audience row were shared with all contacts.
+const visibleToViewer = `s.user_id <> $1
+	AND EXISTS (SELECT 1 FROM contacts c WHERE c.user_id = $1 AND c.contact_user_id = s.user_id)
+	AND EXISTS (SELECT 1 FROM contacts pc WHERE pc.user_id = s.user_id AND pc.contact_user_id = $1)
+	AND NOT EXISTS (
+		SELECT 1 FROM blocked_users b
+		WHERE (b.blocker_user_id = s.user_id AND b.blocked_user_id = $1)
+		OR (b.blocker_user_id = $1 AND b.blocked_user_id = s.user_id)
+	)
+	AND CASE COALESCE((SELECT a.mode FROM status_audiences a WHERE a.status_id = s.id), 'contacts')
+		WHEN 'contacts' THEN TRUE
+		WHEN 'contacts_except' THEN NOT EXISTS (
+			SELECT 1 FROM status_audience_members am WHERE am.status_id = s.id AND am.user_id = $1
+		)
+		WHEN 'only_share_with' THEN EXISTS (
+			SELECT 1 FROM status_audience_members am WHERE am.status_id = s.id AND am.user_id = $1
+		)
+		ELSE FALSE
+	END`
+
+func (s *StatusStore) ListVisible(ctx context.Context, viewerID string, at time.Time) ([]*domain.StatusUpdate, error) {
+	q := `SELECT ` + statusColumns + ` FROM status_updates s
+	WHERE s.expires_at > $2 AND ` + visibleToViewer + `
+	ORDER BY s.user_id, s.created_at ASC, s.id ASC`
+	rows, err := s.db.QueryContext(ctx, q, viewerID, at)
+	if err != nil {
+		return nil, err
+	}
+	return scanStatuses(rows)
+}
+
+func (s *StatusStore) CanView(ctx context.Context, statusID string, viewerID string) (bool, error) {
+	q := `SELECT EXISTS (SELECT 1 FROM status_updates s WHERE s.id = $2 AND ` + visibleToViewer + `)`
+	var ok bool
+	err := s.db.QueryRowContext(ctx, q, viewerID, statusID).Scan(&ok)
+	return ok, err
+}
+
+func (s *StatusStore) FindStatusPrivacy(ctx context.Context, userID string) (domain.PrivacyAudience, []uuid.UUID, error) {
+	var audience string
+	err := s.db.QueryRowContext(ctx, `SELECT status FROM privacy_settings WHERE user_id = $1`, userID).Scan(&audience)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.DefaultPrivacySettings(uuid.Nil).Audience(domain.StatusSetting), nil, nil
+	}
+	if err != nil {
+		return "", nil, err
+	}
+
+	q := `SELECT excluded_user_id FROM privacy_exceptions WHERE user_id = $1 AND setting = $2`
+	rows, err := s.db.QueryContext(ctx, q, userID, string(domain.StatusSetting))
+	if err != nil {
+		return "", nil, err
+	}
+	defer rows.Close()
+	var excluded []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return "", nil, err
+		}
+		excluded = append(excluded, id)
+	}
+	return domain.PrivacyAudience(audience), excluded, rows.Err()
+}
+
+func (s *StatusStore) Delete(ctx context.Context, statusID string) (bool, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM status_views WHERE status_id = $1`, statusID); err != nil {
+		return false, err
+	}
+	res, err := tx.ExecContext(ctx, `DELETE FROM status_updates WHERE id = $1`, statusID)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, tx.Commit()
+}
+
+func (s *StatusStore) DeleteExpired(ctx context.Context, now time.Time, limit int) (int, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	// SKIP LOCKED lets several workers sweep concurrently without contending on a batch.
+	q := `SELECT id FROM status_updates WHERE expires_at <= $1 ORDER BY expires_at LIMIT $2 FOR UPDATE SKIP LOCKED`
+	rows, err := tx.QueryContext(ctx, q, now, limit)
+	if err != nil {
+		return 0, err
+	}
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	batch := pq.Array(ids)
+	if _, err := tx.ExecContext(ctx, `DELETE FROM status_views WHERE status_id = ANY($1::uuid[])`, batch); err != nil {
+		return 0, err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM status_updates WHERE id = ANY($1::uuid[])`, batch); err != nil {
+		return 0, err
+	}
+	return len(ids), tx.Commit()
+}
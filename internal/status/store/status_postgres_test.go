@@ -0,0 +1,122 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/google/uuid"
+	_ "github.com/lib/pq"
+)
+
+// testDB opens the migrated database named by TEST_DATABASE_URL; without it the test is
+// skipped.
+func testDB(t *testing.T) *sql.DB {
+	t.Helper()
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL not set")
+	}
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+// newUser creates a user who is removed, with their statuses, contacts and blocks, when
+// the test ends.
+func newUser(t *testing.T, db *sql.DB) uuid.UUID {
+	t.Helper()
+	id := uuid.New()
+	phone := fmt.Sprintf("+1%010d", rand.Int63n(1e10))
+	if _, err := db.Exec(`INSERT INTO users (id, phone_number) VALUES ($1, $2)`, id, phone); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		for _, q := range []string{
+			`DELETE FROM outbox_events WHERE aggregate_id = $1::text`,
+			`DELETE FROM status_audience_members WHERE user_id = $1 OR status_id IN (SELECT id FROM status_updates WHERE user_id = $1)`,
+			`DELETE FROM status_updates WHERE user_id = $1`,
+			`DELETE FROM contacts WHERE user_id = $1 OR contact_user_id = $1`,
+			`DELETE FROM blocked_users WHERE blocker_user_id = $1 OR blocked_user_id = $1`,
+			`DELETE FROM users WHERE id = $1`,
+		} {
+			db.Exec(q, id)
+		}
+	})
+	return id
+}
+
+// addContact puts contact in owner's address book.
+func addContact(t *testing.T, db *sql.DB, owner uuid.UUID, contact uuid.UUID) {
+	t.Helper()
+	q := `INSERT INTO contacts (user_id, contact_phone_number, contact_user_id)
+	SELECT $1, phone_number, id FROM users WHERE id = $2`
+	if _, err := db.Exec(q, owner, contact); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCanViewFollowsAudience(t *testing.T) {
+	db := testDB(t)
+	ctx := context.Background()
+	s := NewStatusStore(db)
+
+	poster := newUser(t, db)
+	friend, excluded, chosen, oneWay, blocked := newUser(t, db), newUser(t, db), newUser(t, db), newUser(t, db), newUser(t, db)
+	for _, u := range []uuid.UUID{friend, excluded, chosen, blocked} {
+		addContact(t, db, poster, u)
+		addContact(t, db, u, poster)
+	}
+	// oneWay has the poster in their contacts, but not the other way round.
+	addContact(t, db, oneWay, poster)
+	if _, err := db.Exec(`INSERT INTO blocked_users (blocker_user_id, blocked_user_id) VALUES ($1, $2)`, blocked, poster); err != nil {
+		t.Fatal(err)
+	}
+
+	post := func(audience *domain.StatusAudience) string {
+		t.Helper()
+		st := &domain.StatusUpdate{UserID: poster, ContentType: domain.TextStatus, ContentOrURL: "hi", ExpiresAt: time.Now().Add(time.Hour), Audience: audience}
+		if err := s.Create(ctx, st); err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+		return st.ID.String()
+	}
+	all := post(nil)
+	except := post(&domain.StatusAudience{Mode: domain.AudienceAllContactsBut, UserIDs: []uuid.UUID{excluded}})
+	only := post(&domain.StatusAudience{Mode: domain.AudienceOnlyShareWith, UserIDs: []uuid.UUID{chosen}})
+
+	tests := []struct {
+		name   string
+		status string
+		viewer uuid.UUID
+		want   bool
+	}{
+		{"all contacts shows mutual contacts", all, friend, true},
+		{"all contacts hides one-way contacts", all, oneWay, false},
+		{"blocks hide every status", all, blocked, false},
+		{"posters do not view their own", all, poster, false},
+		{"contacts except shows other contacts", except, friend, true},
+		{"contacts except hides the excluded", except, excluded, false},
+		{"only share with shows the chosen", only, chosen, true},
+		{"only share with hides other contacts", only, friend, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.CanView(ctx, tt.status, tt.viewer.String())
+			if err != nil {
+				t.Fatalf("CanView: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("CanView = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
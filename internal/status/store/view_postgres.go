@@ -0,0 +1,79 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/dykethecreator/GoApp/internal/status/repository"
+	"github.com/dykethecreator/GoApp/pkg/domain"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// ViewStore implements ViewRepository for PostgreSQL.
+type ViewStore struct {
+	db *sql.DB
+}
+
+func NewViewStore(db *sql.DB) repository.ViewRepository {
+	return &ViewStore{db: db}
+}
+
+func (s *ViewStore) RecordView(ctx context.Context, statusID string, viewerID string, at time.Time) (bool, error) {
+	q := `INSERT INTO status_views (status_id, user_id, viewed_at, receipt_hidden)
+	VALUES ($1, $2, $3, NOT COALESCE((SELECT read_receipts FROM privacy_settings WHERE user_id = $2), TRUE))
+	ON CONFLICT (status_id, user_id) DO NOTHING`
+	res, err := s.db.ExecContext(ctx, q, statusID, viewerID, at)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
+
+func (s *ViewStore) ListViewers(ctx context.Context, statusID string) ([]*domain.StatusView, error) {
+	q := `SELECT status_id, user_id, viewed_at FROM status_views
+	WHERE status_id = $1 AND NOT receipt_hidden
+	ORDER BY viewed_at DESC, user_id`
+	rows, err := s.db.QueryContext(ctx, q, statusID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []*domain.StatusView
+	for rows.Next() {
+		var v domain.StatusView
+		if err := rows.Scan(&v.StatusID, &v.UserID, &v.ViewedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, &v)
+	}
+	return out, rows.Err()
+}
+
+func (s *ViewStore) ListViewed(ctx context.Context, viewerID string, statusIDs []uuid.UUID) (map[uuid.UUID]bool, error) {
+	out := map[uuid.UUID]bool{}
+	if len(statusIDs) == 0 {
+		return out, nil
+	}
+	ids := make([]string, 0, len(statusIDs))
+	for _, id := range statusIDs {
+		ids = append(ids, id.String())
+	}
+	q := `SELECT status_id FROM status_views WHERE user_id = $1 AND status_id = ANY($2::uuid[])`
+	rows, err := s.db.QueryContext(ctx, q, viewerID, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		out[id] = true
+	}
+	return out, rows.Err()
+}
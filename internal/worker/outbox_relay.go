@@ -0,0 +1,76 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/dykethecreator/GoApp/internal/outbox"
+	"github.com/dykethecreator/GoApp/pkg/eventbus"
+)
+
+// OutboxRelay publishes the events services recorded in the outbox and prunes the ones
+// sent long enough ago.
+type OutboxRelay struct {
+	outbox    outbox.Repository
+	publisher eventbus.Publisher
+	interval  time.Duration
+	batchSize int
+	// retention is how long sent events are kept.
+	retention time.Duration
+}
+
+// NewOutboxRelay creates a relay that polls every interval and publishes at most
+// batchSize events per transaction.
+func NewOutboxRelay(repo outbox.Repository, publisher eventbus.Publisher, interval time.Duration, batchSize int, retention time.Duration) *OutboxRelay {
+	return &OutboxRelay{outbox: repo, publisher: publisher, interval: interval, batchSize: batchSize, retention: retention}
+}
+
+// Run relays immediately and then on every tick until ctx is cancelled.
+func (r *OutboxRelay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		if n, err := r.Relay(ctx); err != nil {
+			log.Printf("Warning: outbox relay failed after publishing %d events: %v", n, err)
+		}
+		if n, err := r.outbox.Prune(ctx, time.Now().Add(-r.retention), r.batchSize); err != nil {
+			log.Printf("Warning: outbox prune failed: %v", err)
+		} else if n > 0 {
+			log.Printf("Pruned %d outbox rows", n)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Relay publishes pending events batch by batch until none are left, and returns how many
+// were published.
+func (r *OutboxRelay) Relay(ctx context.Context) (int, error) {
+	publish := func(ctx context.Context, events []outbox.Event) error {
+		msgs := make([]eventbus.Message, 0, len(events))
+		for _, ev := range events {
+			msg, err := eventbus.EnvelopeMessage(ev.Topic, ev.Key, ev.Envelope)
+			if err != nil {
+				return err
+			}
+			msgs = append(msgs, msg)
+		}
+		return eventbus.PublishAll(ctx, r.publisher, msgs)
+	}
+	total := 0
+	for ctx.Err() == nil {
+		n, err := r.outbox.Relay(ctx, r.batchSize, publish)
+		total += n
+		if err != nil {
+			return total, err
+		}
+		if n < r.batchSize {
+			break
+		}
+	}
+	return total, nil
+}
@@ -0,0 +1,77 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	chatrepo "github.com/dykethecreator/GoApp/internal/chat/repository"
+	statusrepo "github.com/dykethecreator/GoApp/internal/status/repository"
+)
+
+// SweepFunc deletes at most limit rows that are due and returns how many it deleted.
+type SweepFunc func(ctx context.Context, limit int) (int, error)
+
+// BatchSweeper periodically deletes rows that are due, a batch per transaction.
+type BatchSweeper struct {
+	what      string // what is deleted, for logs, e.g. "expired messages"
+	sweep     SweepFunc
+	interval  time.Duration
+	batchSize int
+}
+
+// NewBatchSweeper creates a sweeper that runs every interval and calls sweep with a limit
+// of batchSize until a batch comes back short.
+func NewBatchSweeper(what string, sweep SweepFunc, interval time.Duration, batchSize int) *BatchSweeper {
+	return &BatchSweeper{what: what, sweep: sweep, interval: interval, batchSize: batchSize}
+}
+
+// NewMessageSweeper creates a sweeper that hard-deletes messages whose disappearing-messages
+// expiry has passed.
+func NewMessageSweeper(messages chatrepo.MessageRepository, interval time.Duration, batchSize int) *BatchSweeper {
+	return NewBatchSweeper("expired messages", func(ctx context.Context, limit int) (int, error) {
+		return messages.DeleteExpired(ctx, time.Now(), limit)
+	}, interval, batchSize)
+}
+
+// NewStatusSweeper creates a sweeper that deletes status updates whose expiry has passed.
+func NewStatusSweeper(statuses statusrepo.StatusRepository, interval time.Duration, batchSize int) *BatchSweeper {
+	return NewBatchSweeper("expired statuses", func(ctx context.Context, limit int) (int, error) {
+		return statuses.DeleteExpired(ctx, time.Now(), limit)
+	}, interval, batchSize)
+}
+
+// Run sweeps immediately and then on every tick until ctx is cancelled.
+func (s *BatchSweeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		if n, err := s.Sweep(ctx); err != nil {
+			log.Printf("Warning: sweep of %s failed after deleting %d: %v", s.what, n, err)
+		} else if n > 0 {
+			log.Printf("Deleted %d %s", n, s.what)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sweep deletes due rows batch by batch until none are left, and returns how many were
+// deleted.
+func (s *BatchSweeper) Sweep(ctx context.Context) (int, error) {
+	total := 0
+	for ctx.Err() == nil {
+		n, err := s.sweep(ctx, s.batchSize)
+		total += n
+		if err != nil {
+			return total, err
+		}
+		if n < s.batchSize {
+			break
+		}
+	}
+	return total, nil
+}
@@ -0,0 +1,7 @@
+-- Revert message API changes
+DROP TABLE IF EXISTS message_deletions;
+
+DROP INDEX IF EXISTS messages_client_message_id_idx;
+
+ALTER TABLE messages
+    DROP COLUMN IF EXISTS client_message_id;
@@ -0,0 +1,17 @@
+-- Support the message send/edit/delete/history API
+
+-- Client-generated idempotency key so retried sends do not create duplicates
+ALTER TABLE messages
+    ADD COLUMN IF NOT EXISTS client_message_id VARCHAR(64);
+
+-- One message per (chat, sender, client key); NULL keys never conflict
+CREATE UNIQUE INDEX IF NOT EXISTS messages_client_message_id_idx
+    ON messages (chat_id, sender_id, client_message_id);
+
+-- "Delete for me": hides a message for a single user without touching it for others
+CREATE TABLE IF NOT EXISTS message_deletions (
+    message_id BIGINT NOT NULL REFERENCES messages(id),
+    user_id uuid NOT NULL REFERENCES users(id),
+    deleted_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (message_id, user_id)
+);
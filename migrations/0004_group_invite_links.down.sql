@@ -0,0 +1,3 @@
+-- Revert group invite links
+DROP TABLE IF EXISTS group_join_requests;
+DROP TABLE IF EXISTS group_invite_links;
@@ -0,0 +1,18 @@
+-- Group invite links and the join-approval queue
+
+-- One active invite code per group; resetting replaces the code, revoking the old link
+CREATE TABLE IF NOT EXISTS group_invite_links (
+    chat_id uuid PRIMARY KEY REFERENCES chats(id),
+    code VARCHAR(32) UNIQUE NOT NULL,
+    approval_required BOOLEAN NOT NULL DEFAULT FALSE, -- joins via link wait for an admin
+    created_by_user_id uuid NOT NULL REFERENCES users(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Pending join requests; a row is removed once an admin approves or rejects it
+CREATE TABLE IF NOT EXISTS group_join_requests (
+    chat_id uuid NOT NULL REFERENCES chats(id),
+    user_id uuid NOT NULL REFERENCES users(id),
+    requested_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (chat_id, user_id)
+);
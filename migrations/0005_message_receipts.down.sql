@@ -0,0 +1,6 @@
+-- Revert receipt columns
+DROP INDEX IF EXISTS message_statuses_user_id_idx;
+
+ALTER TABLE message_statuses
+    DROP COLUMN IF EXISTS read_at,
+    DROP COLUMN IF EXISTS delivered_at;
@@ -0,0 +1,9 @@
+-- Delivery and read receipts
+-- status/"timestamp" keep the latest state; delivered_at/read_at keep both milestones
+-- so message info can show when each member received and read a message.
+ALTER TABLE message_statuses
+    ADD COLUMN IF NOT EXISTS delivered_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS read_at TIMESTAMPTZ;
+
+-- Receipt lookups per reader
+CREATE INDEX IF NOT EXISTS message_statuses_user_id_idx ON message_statuses (user_id);
@@ -0,0 +1,2 @@
+-- Clear search vectors (the column and its GIN index belong to the initial schema)
+UPDATE messages SET content_search_vector = NULL;
@@ -0,0 +1,10 @@
+-- Full-text message search
+-- chat_service fills content_search_vector on insert/edit using CHAT_SEARCH_LANGUAGE
+-- (a Postgres text search configuration such as 'simple', 'turkish' or 'english').
+-- Backfill existing user-authored messages with 'simple', which works for both Turkish
+-- and English. If you run with another configuration, re-run this UPDATE with it.
+UPDATE messages
+SET content_search_vector = to_tsvector('simple', content)
+WHERE content_type IN ('text', 'image', 'video', 'audio', 'file')
+  AND deleted_at IS NULL
+  AND content IS NOT NULL;
@@ -0,0 +1,2 @@
+-- Revert pinned messages
+DROP TABLE IF EXISTS pinned_messages;
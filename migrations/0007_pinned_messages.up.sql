@@ -0,0 +1,23 @@
+-- Multiple pinned messages per chat, each with its own expiry
+
+CREATE TABLE IF NOT EXISTS pinned_messages (
+    chat_id uuid NOT NULL REFERENCES chats(id),
+    message_id BIGINT NOT NULL REFERENCES messages(id) ON DELETE CASCADE,
+    pinned_by_user_id uuid NOT NULL REFERENCES users(id),
+    pinned_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ NOT NULL, -- the pin disappears after this moment
+    PRIMARY KEY (chat_id, message_id)
+);
+
+CREATE INDEX IF NOT EXISTS pinned_messages_chat_id_pinned_at_idx ON pinned_messages (chat_id, pinned_at DESC);
+
+-- chats.pinned_message_id predates multi-pin and is no longer written; carry existing
+-- pins over with the longest expiry. Who pinned them was never recorded, so they are
+-- credited to the chat's creator, or else the message's sender; pins with neither are dropped.
+INSERT INTO pinned_messages (chat_id, message_id, pinned_by_user_id, pinned_at, expires_at)
+SELECT c.id, c.pinned_message_id, COALESCE(c.created_by_user_id, m.sender_id), NOW(), NOW() + INTERVAL '30 days'
+FROM chats c
+JOIN messages m ON m.id = c.pinned_message_id
+WHERE c.pinned_message_id IS NOT NULL
+AND COALESCE(c.created_by_user_id, m.sender_id) IS NOT NULL
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,14 @@
+-- Revert poll settings; multi-select voters keep only their earliest choice
+DROP INDEX IF EXISTS poll_options_poll_id_idx;
+
+DELETE FROM poll_votes v
+USING poll_votes earlier
+WHERE v.poll_id = earlier.poll_id AND v.user_id = earlier.user_id
+AND (earlier.voted_at, earlier.option_id) < (v.voted_at, v.option_id);
+
+ALTER TABLE poll_votes DROP CONSTRAINT IF EXISTS poll_votes_pkey;
+ALTER TABLE poll_votes ADD PRIMARY KEY (poll_id, user_id);
+ALTER TABLE poll_votes DROP COLUMN IF EXISTS voted_at;
+
+ALTER TABLE polls DROP COLUMN IF EXISTS closed_at;
+ALTER TABLE polls DROP COLUMN IF EXISTS allow_multiple_answers;
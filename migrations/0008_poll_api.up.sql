@@ -0,0 +1,12 @@
+-- Poll settings, closing and multi-select votes
+
+ALTER TABLE polls ADD COLUMN IF NOT EXISTS allow_multiple_answers BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE polls ADD COLUMN IF NOT EXISTS closed_at TIMESTAMPTZ; -- no more votes once set
+
+ALTER TABLE poll_votes ADD COLUMN IF NOT EXISTS voted_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+-- A voter may now choose several options of a multi-select poll
+ALTER TABLE poll_votes DROP CONSTRAINT IF EXISTS poll_votes_pkey;
+ALTER TABLE poll_votes ADD PRIMARY KEY (poll_id, user_id, option_id);
+
+CREATE INDEX IF NOT EXISTS poll_options_poll_id_idx ON poll_options (poll_id);
@@ -0,0 +1,3 @@
+-- Revert forwarded messages
+ALTER TABLE messages DROP COLUMN IF EXISTS forward_count;
+ALTER TABLE messages DROP COLUMN IF EXISTS forwarded;
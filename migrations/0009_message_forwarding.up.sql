@@ -0,0 +1,6 @@
+-- Forwarded messages
+
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS forwarded BOOLEAN NOT NULL DEFAULT FALSE;
+-- How many forwarding hops separate this copy from the original; large values mark
+-- "frequently forwarded" content
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS forward_count INT NOT NULL DEFAULT 0;
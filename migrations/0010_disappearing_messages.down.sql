@@ -0,0 +1,4 @@
+-- Revert disappearing messages
+DROP INDEX IF EXISTS messages_expires_at_idx;
+ALTER TABLE messages DROP COLUMN IF EXISTS expires_at;
+ALTER TABLE chats DROP COLUMN IF EXISTS message_timer_seconds;
@@ -0,0 +1,9 @@
+-- Disappearing messages
+
+-- Per-chat timer in seconds; 0 means off
+ALTER TABLE chats ADD COLUMN IF NOT EXISTS message_timer_seconds INT NOT NULL DEFAULT 0;
+
+-- Stamped on each message sent while the timer is on; the message worker hard-deletes
+-- messages once this moment has passed
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS expires_at TIMESTAMPTZ;
+CREATE INDEX IF NOT EXISTS messages_expires_at_idx ON messages (expires_at) WHERE expires_at IS NOT NULL;
@@ -0,0 +1,3 @@
+-- Revert contact sync indexes
+DROP INDEX IF EXISTS contacts_contact_user_id_idx;
+DROP INDEX IF EXISTS contacts_contact_phone_number_idx;
@@ -0,0 +1,4 @@
+-- Contact sync: look up address book entries by phone number when a number registers
+CREATE INDEX IF NOT EXISTS contacts_contact_phone_number_idx ON contacts (contact_phone_number);
+-- "Which users have me in their contacts?" for privacy checks
+CREATE INDEX IF NOT EXISTS contacts_contact_user_id_idx ON contacts (contact_user_id) WHERE contact_user_id IS NOT NULL;
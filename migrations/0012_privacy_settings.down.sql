@@ -0,0 +1,3 @@
+-- Revert privacy settings
+DROP TABLE IF EXISTS privacy_exceptions;
+DROP TABLE IF EXISTS privacy_settings;
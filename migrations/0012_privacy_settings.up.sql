@@ -0,0 +1,22 @@
+-- Per-user privacy settings
+
+-- Each column holds 'everyone', 'contacts', 'contacts_except' or 'nobody'.
+-- Users without a row use 'everyone' for every setting except status, which is 'contacts'.
+CREATE TABLE IF NOT EXISTS privacy_settings (
+    user_id uuid PRIMARY KEY REFERENCES users(id),
+    last_seen VARCHAR(20) NOT NULL DEFAULT 'everyone',
+    online VARCHAR(20) NOT NULL DEFAULT 'everyone',
+    profile_photo VARCHAR(20) NOT NULL DEFAULT 'everyone',
+    about VARCHAR(20) NOT NULL DEFAULT 'everyone',
+    status VARCHAR(20) NOT NULL DEFAULT 'contacts',
+    group_add VARCHAR(20) NOT NULL DEFAULT 'everyone',
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Contacts excluded from a 'contacts_except' setting
+CREATE TABLE IF NOT EXISTS privacy_exceptions (
+    user_id uuid NOT NULL REFERENCES users(id),
+    setting VARCHAR(20) NOT NULL, -- 'last_seen', 'online', 'profile_photo', 'about', 'status' or 'group_add'
+    excluded_user_id uuid NOT NULL REFERENCES users(id),
+    PRIMARY KEY (user_id, setting, excluded_user_id)
+);
@@ -0,0 +1,6 @@
+-- Revert chat list preferences
+DROP INDEX IF EXISTS chat_members_pinned_idx;
+ALTER TABLE chat_members DROP COLUMN IF EXISTS pinned_at;
+ALTER TABLE chat_members DROP COLUMN IF EXISTS marked_unread;
+ALTER TABLE chat_members DROP COLUMN IF EXISTS unarchive_on_message;
+ALTER TABLE chat_members DROP COLUMN IF EXISTS muted_until;
@@ -0,0 +1,11 @@
+-- Per-member chat list preferences: mute expiry, archive behaviour, mark-as-unread and pinning
+
+-- With is_muted set, NULL means muted until unmuted ("always")
+ALTER TABLE chat_members ADD COLUMN IF NOT EXISTS muted_until TIMESTAMPTZ;
+-- When set, a new message from someone else moves the chat out of the archive
+ALTER TABLE chat_members ADD COLUMN IF NOT EXISTS unarchive_on_message BOOLEAN NOT NULL DEFAULT FALSE;
+-- Set by "mark as unread"; cleared once the member reads the chat
+ALTER TABLE chat_members ADD COLUMN IF NOT EXISTS marked_unread BOOLEAN NOT NULL DEFAULT FALSE;
+-- Chats pinned to the top of the member's chat list, most recently pinned first
+ALTER TABLE chat_members ADD COLUMN IF NOT EXISTS pinned_at TIMESTAMPTZ;
+CREATE INDEX IF NOT EXISTS chat_members_pinned_idx ON chat_members (user_id) WHERE pinned_at IS NOT NULL;
@@ -0,0 +1,3 @@
+-- Revert status service columns
+DROP INDEX IF EXISTS status_updates_expires_at_idx;
+ALTER TABLE status_updates DROP COLUMN IF EXISTS caption;
@@ -0,0 +1,6 @@
+-- Status (stories) service
+
+-- Caption of image and video statuses; content_or_url holds the text or the media URL
+ALTER TABLE status_updates ADD COLUMN IF NOT EXISTS caption TEXT;
+-- The status sweeper deletes by expiry
+CREATE INDEX IF NOT EXISTS status_updates_expires_at_idx ON status_updates (expires_at);
@@ -0,0 +1,4 @@
+-- Revert status view receipts
+DROP INDEX IF EXISTS status_views_user_id_idx;
+ALTER TABLE status_views DROP COLUMN IF EXISTS receipt_hidden;
+ALTER TABLE privacy_settings DROP COLUMN IF EXISTS read_receipts;
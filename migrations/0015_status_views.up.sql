@@ -0,0 +1,9 @@
+-- Status view receipts
+
+-- Turning read receipts off hides the user's status views from posters
+ALTER TABLE privacy_settings ADD COLUMN IF NOT EXISTS read_receipts BOOLEAN NOT NULL DEFAULT TRUE;
+
+-- Captured when the view is recorded: the viewer had read receipts off, so the poster
+-- does not see this view
+ALTER TABLE status_views ADD COLUMN IF NOT EXISTS receipt_hidden BOOLEAN NOT NULL DEFAULT FALSE;
+CREATE INDEX IF NOT EXISTS status_views_user_id_idx ON status_views (user_id);
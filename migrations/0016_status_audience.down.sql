@@ -0,0 +1,3 @@
+-- Revert status audiences
+DROP TABLE IF EXISTS status_audience_members;
+DROP TABLE IF EXISTS status_audiences;
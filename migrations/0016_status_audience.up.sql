@@ -0,0 +1,18 @@
+-- Status audiences, captured when a status is posted
+
+CREATE TABLE IF NOT EXISTS status_audiences (
+    status_id uuid PRIMARY KEY REFERENCES status_updates(id) ON DELETE CASCADE,
+    mode VARCHAR(20) NOT NULL -- 'contacts', 'contacts_except' or 'only_share_with'
+);
+
+-- The excluded contacts of 'contacts_except', or the chosen contacts of 'only_share_with'
+CREATE TABLE IF NOT EXISTS status_audience_members (
+    status_id uuid NOT NULL REFERENCES status_updates(id) ON DELETE CASCADE,
+    user_id uuid NOT NULL REFERENCES users(id),
+    PRIMARY KEY (status_id, user_id)
+);
+
+-- Statuses posted before audiences existed were shared with all contacts
+INSERT INTO status_audiences (status_id, mode)
+SELECT id, 'contacts' FROM status_updates
+ON CONFLICT (status_id) DO NOTHING;
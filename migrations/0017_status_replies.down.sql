@@ -0,0 +1,4 @@
+-- Revert status replies
+DROP INDEX IF EXISTS messages_reply_to_status_id_idx;
+ALTER TABLE messages DROP COLUMN IF EXISTS status_preview;
+ALTER TABLE messages DROP COLUMN IF EXISTS reply_to_status_id;
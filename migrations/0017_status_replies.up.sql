@@ -0,0 +1,8 @@
+-- Status replies: chat messages that quote a status
+
+-- No foreign key: statuses are deleted when they expire, while the reply and its
+-- preview stay in the chat.
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS reply_to_status_id uuid;
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS status_preview JSONB;
+
+CREATE INDEX IF NOT EXISTS messages_reply_to_status_id_idx ON messages(reply_to_status_id) WHERE reply_to_status_id IS NOT NULL;
@@ -0,0 +1,2 @@
+-- Revert the realtime connection registry
+DROP TABLE IF EXISTS realtime_connections;
@@ -0,0 +1,13 @@
+-- Registry of open WebSocket connections across realtime_service nodes
+
+CREATE TABLE IF NOT EXISTS realtime_connections (
+    connection_id uuid PRIMARY KEY,
+    user_id uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    device_id VARCHAR(128) NOT NULL,
+    node_id VARCHAR(128) NOT NULL,
+    connected_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    heartbeat_at TIMESTAMPTZ NOT NULL DEFAULT NOW() -- refreshed by the serving node
+);
+
+CREATE INDEX IF NOT EXISTS realtime_connections_user_id_idx ON realtime_connections(user_id);
+CREATE INDEX IF NOT EXISTS realtime_connections_node_id_idx ON realtime_connections(node_id);
@@ -0,0 +1,3 @@
+-- Revert offline sync
+DROP TABLE IF EXISTS sync_cursors;
+DROP TABLE IF EXISTS sync_events;
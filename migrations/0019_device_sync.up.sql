@@ -0,0 +1,26 @@
+-- Offline sync: an ordered log of chat changes and per-device read positions in it
+
+CREATE TABLE IF NOT EXISTS sync_events (
+    seq BIGSERIAL PRIMARY KEY,
+    chat_id uuid NOT NULL REFERENCES chats(id) ON DELETE CASCADE,
+    user_id uuid REFERENCES users(id) ON DELETE CASCADE, -- when set, only this user gets the event
+    kind VARCHAR(32) NOT NULL, -- 'message', 'edit', 'delete', 'delete_for_me' or 'receipt'
+    message_id BIGINT NOT NULL, -- no foreign key: expired messages are removed
+    payload JSONB,
+    -- Writing transaction. The log is read in (txid, seq) order and only up to the oldest
+    -- running transaction, so an event never lands behind a position already served.
+    txid xid8 NOT NULL DEFAULT pg_current_xact_id(),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS sync_events_txid_seq_idx ON sync_events(txid, seq);
+
+CREATE TABLE IF NOT EXISTS sync_cursors (
+    user_id uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    device_id VARCHAR(128) NOT NULL,
+    -- Position of the last event the device acknowledged.
+    last_txid xid8 NOT NULL DEFAULT '0',
+    last_seq BIGINT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, device_id)
+);
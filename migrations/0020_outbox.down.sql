@@ -0,0 +1,2 @@
+-- Revert the transactional outbox
+DROP TABLE IF EXISTS outbox_events;
@@ -0,0 +1,23 @@
+-- Transactional outbox: domain events written in the transaction of the change they
+-- describe, then relayed to the event bus by message_worker
+
+CREATE TABLE IF NOT EXISTS outbox_events (
+    id BIGSERIAL PRIMARY KEY,
+    event_id uuid NOT NULL UNIQUE, -- the envelope's event ID, for consumers to drop duplicates
+    topic VARCHAR(255) NOT NULL,
+    aggregate_id VARCHAR(255) NOT NULL, -- message key; one aggregate's events are relayed in order
+    event_type VARCHAR(255) NOT NULL,
+    envelope BYTEA NOT NULL, -- events.EventEnvelope, protobuf encoded
+    -- Writing transaction. Events are relayed in (txid, id) order and only up to the
+    -- oldest running transaction, so a late commit never lands behind relayed events.
+    txid xid8 NOT NULL DEFAULT pg_current_xact_id(),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    sent_at TIMESTAMPTZ,
+    -- Set instead of sent_at when the envelope cannot be decoded; the row is kept for
+    -- inspection and the relay moves past it.
+    failed_at TIMESTAMPTZ,
+    last_error TEXT
+);
+
+CREATE INDEX IF NOT EXISTS outbox_events_unsent_idx ON outbox_events(txid, id) WHERE sent_at IS NULL AND failed_at IS NULL;
+CREATE INDEX IF NOT EXISTS outbox_events_sent_at_idx ON outbox_events(sent_at) WHERE sent_at IS NOT NULL;
@@ -0,0 +1,3 @@
+-- Revert the poll to message link
+DROP INDEX IF EXISTS polls_message_id_idx;
+ALTER TABLE polls DROP COLUMN IF EXISTS message_id;
@@ -0,0 +1,12 @@
+-- Link each poll to the PollContent message that posted it
+
+-- NULL once the message is hard-deleted; such polls no longer take votes
+ALTER TABLE polls ADD COLUMN IF NOT EXISTS message_id BIGINT REFERENCES messages(id) ON DELETE SET NULL;
+
+UPDATE polls p SET message_id = m.id
+FROM messages m
+WHERE p.message_id IS NULL AND m.chat_id = p.chat_id
+-- CASE keeps the cast away from content that is not JSON
+AND CASE WHEN m.content_type = 'poll' THEN m.content::jsonb ->> 'poll_id' END = p.id::text;
+
+CREATE INDEX IF NOT EXISTS polls_message_id_idx ON polls (message_id);
@@ -0,0 +1,2 @@
+-- Revert sync prune marks
+DROP TABLE IF EXISTS sync_prune_marks;
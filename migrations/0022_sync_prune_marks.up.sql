@@ -0,0 +1,9 @@
+-- Newest pruned sync position per chat. A device whose cursor is behind the mark of one of
+-- its chats missed changes that are gone from the log and has to reload.
+
+CREATE TABLE IF NOT EXISTS sync_prune_marks (
+    chat_id uuid PRIMARY KEY REFERENCES chats(id) ON DELETE CASCADE,
+    last_txid xid8 NOT NULL,
+    last_seq BIGINT NOT NULL,
+    last_created_at TIMESTAMPTZ NOT NULL -- of the pruned event, to ignore marks from before a member joined
+);
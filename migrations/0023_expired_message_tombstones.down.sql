@@ -0,0 +1,3 @@
+-- Revert the expiry sweep index to cover every expiring message
+DROP INDEX IF EXISTS messages_expires_at_idx;
+CREATE INDEX IF NOT EXISTS messages_expires_at_idx ON messages (expires_at) WHERE expires_at IS NOT NULL;
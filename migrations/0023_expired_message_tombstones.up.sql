@@ -0,0 +1,6 @@
+-- Expired messages are kept as tombstones so replies keep their link to them. The sweep
+-- only looks for messages that are not deleted yet, so leave tombstones out of its index.
+
+DROP INDEX IF EXISTS messages_expires_at_idx;
+CREATE INDEX IF NOT EXISTS messages_expires_at_idx ON messages (expires_at)
+    WHERE expires_at IS NOT NULL AND deleted_at IS NULL;
@@ -0,0 +1,48 @@
+package config
+
+import (
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// EnvDuration reads a positive duration setting, def when unset. An invalid value stops
+// the process.
+func EnvDuration(name string, def time.Duration) time.Duration {
+	v := os.Getenv(name)
+	if v == "" {
+		return def
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d <= 0 {
+		log.Fatalf("invalid %s %q", name, v)
+	}
+	return d
+}
+
+// EnvInt reads a positive integer setting, def when unset. An invalid value stops the
+// process.
+func EnvInt(name string, def int) int {
+	v := os.Getenv(name)
+	if v == "" {
+		return def
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 1 {
+		log.Fatalf("invalid %s %q", name, v)
+	}
+	return n
+}
+
+// EnvList splits a comma separated setting, dropping blanks.
+func EnvList(name string) []string {
+	var out []string
+	for _, s := range strings.Split(os.Getenv(name), ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			out = append(out, s)
+		}
+	}
+	return out
+}
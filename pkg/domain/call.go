@@ -18,6 +18,7 @@ const (
 type CallStatus string
 
 const (
+	OngoingCall   CallStatus = "ongoing" // ringing or in progress; replaced when the call ends
 	CompletedCall CallStatus = "completed"
 	MissedCall    CallStatus = "missed"
 	RejectedCall  CallStatus = "rejected"
@@ -25,11 +26,11 @@ const (
 
 // CallLog represents a record of a call.
 type CallLog struct {
-	ID             uuid.UUID  `json:"id" db:"id"`
-	ChatID         uuid.UUID  `json:"chat_id" db:"chat_id"`
-	CallerUserID   uuid.UUID  `json:"caller_user_id" db:"caller_user_id"`
-	CallType       CallType   `json:"call_type" db:"call_type"`
-	Status         CallStatus `json:"status" db:"status"`
-	StartedAt      time.Time  `json:"started_at" db:"started_at"`
-	EndedAt        *time.Time `json:"ended_at,omitempty" db:"ended_at"`
+	ID           uuid.UUID  `json:"id" db:"id"`
+	ChatID       uuid.UUID  `json:"chat_id" db:"chat_id"`
+	CallerUserID uuid.UUID  `json:"caller_user_id" db:"caller_user_id"`
+	CallType     CallType   `json:"call_type" db:"call_type"`
+	Status       CallStatus `json:"status" db:"status"`
+	StartedAt    time.Time  `json:"started_at" db:"started_at"`
+	EndedAt      *time.Time `json:"ended_at,omitempty" db:"ended_at"`
 }
@@ -25,6 +25,8 @@ type Chat struct {
 	CreatedAt        time.Time `json:"created_at" db:"created_at"`
 	LastMessageAt    time.Time `json:"last_message_at" db:"last_message_at"`
 	PinnedMessageID  *int64    `json:"pinned_message_id,omitempty" db:"pinned_message_id"`
+	// MessageTimerSeconds is the disappearing-messages timer; 0 means off.
+	MessageTimerSeconds int `json:"message_timer_seconds" db:"message_timer_seconds"`
 }
 
 // ChatMemberRole defines the role of a member in a chat.
@@ -50,8 +52,41 @@ type ChatMember struct {
 	UserID           uuid.UUID        `json:"user_id" db:"user_id"`
 	Role             ChatMemberRole   `json:"role" db:"role"`
 	MembershipStatus MembershipStatus `json:"membership_status" db:"membership_status"`
-	IsMuted          bool             `json:"is_muted" db:"is_muted"`
-	IsArchived       bool             `json:"is_archived" db:"is_archived"`
-	UnreadCount      int              `json:"unread_count" db:"unread_count"`
-	JoinedAt         time.Time        `json:"joined_at" db:"joined_at"`
+	// IsMuted is false again once MutedUntil has passed; a nil MutedUntil on a muted chat
+	// means muted until the member unmutes it.
+	IsMuted    bool       `json:"is_muted" db:"is_muted"`
+	MutedUntil *time.Time `json:"muted_until,omitempty" db:"muted_until"`
+	IsArchived bool       `json:"is_archived" db:"is_archived"`
+	// UnarchiveOnMessage moves an archived chat back to the inbox when a new message arrives.
+	UnarchiveOnMessage bool       `json:"unarchive_on_message" db:"unarchive_on_message"`
+	UnreadCount        int        `json:"unread_count" db:"unread_count"`
+	MarkedUnread       bool       `json:"marked_unread" db:"marked_unread"`
+	PinnedAt           *time.Time `json:"pinned_at,omitempty" db:"pinned_at"` // nil when not pinned to the chat list
+	JoinedAt           time.Time  `json:"joined_at" db:"joined_at"`
+}
+
+// GroupInviteLink is the invite code of a group chat. Anyone holding the code can
+// join, or ask to join when ApprovalRequired is set.
+type GroupInviteLink struct {
+	ChatID           uuid.UUID `json:"chat_id" db:"chat_id"`
+	Code             string    `json:"code" db:"code"`
+	ApprovalRequired bool      `json:"approval_required" db:"approval_required"`
+	CreatedByUserID  uuid.UUID `json:"created_by_user_id" db:"created_by_user_id"`
+	CreatedAt        time.Time `json:"created_at" db:"created_at"`
+}
+
+// GroupJoinRequest is a pending request to join a group through its invite link.
+type GroupJoinRequest struct {
+	ChatID      uuid.UUID `json:"chat_id" db:"chat_id"`
+	UserID      uuid.UUID `json:"user_id" db:"user_id"`
+	RequestedAt time.Time `json:"requested_at" db:"requested_at"`
+}
+
+// PinnedMessage is a message pinned to the top of a chat until ExpiresAt.
+type PinnedMessage struct {
+	ChatID         uuid.UUID `json:"chat_id" db:"chat_id"`
+	MessageID      int64     `json:"message_id" db:"message_id"`
+	PinnedByUserID uuid.UUID `json:"pinned_by_user_id" db:"pinned_by_user_id"`
+	PinnedAt       time.Time `json:"pinned_at" db:"pinned_at"`
+	ExpiresAt      time.Time `json:"expires_at" db:"expires_at"`
 }
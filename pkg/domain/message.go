@@ -2,6 +2,7 @@ package domain
 
 import (
 	"encoding/json"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -23,19 +24,25 @@ const (
 
 // Message represents a message in a chat.
 type Message struct {
-	ID                  int64           `json:"id" db:"id"`
-	ChatID              uuid.UUID       `json:"chat_id" db:"chat_id"`
-	SenderID            *uuid.UUID      `json:"sender_id,omitempty" db:"sender_id"`
-	ContentType         ContentType     `json:"content_type" db:"content_type"`
-	Content             string          `json:"content" db:"content"`
-	MediaURL            *string         `json:"media_url,omitempty" db:"media_url"`
-	MediaMetadata       json.RawMessage `json:"media_metadata,omitempty" db:"media_metadata"`
-	ReplyToMessageID    *int64          `json:"reply_to_message_id,omitempty" db:"reply_to_message_id"`
-	CreatedAt           time.Time       `json:"created_at" db:"created_at"`
-	ContentSearchVector *string         `json:"-" db:"content_search_vector"`
-	EditedAt            *time.Time      `json:"edited_at,omitempty" db:"edited_at"`
-	DeletedAt           *time.Time      `json:"deleted_at,omitempty" db:"deleted_at"`
-	DeletedByUserID     *uuid.UUID      `json:"deleted_by_user_id,omitempty" db:"deleted_by_user_id"`
+	ID                  int64               `json:"id" db:"id"`
+	ChatID              uuid.UUID           `json:"chat_id" db:"chat_id"`
+	SenderID            *uuid.UUID          `json:"sender_id,omitempty" db:"sender_id"`
+	ContentType         ContentType         `json:"content_type" db:"content_type"`
+	Content             string              `json:"content" db:"content"`
+	MediaURL            *string             `json:"media_url,omitempty" db:"media_url"`
+	MediaMetadata       json.RawMessage     `json:"media_metadata,omitempty" db:"media_metadata"`
+	ReplyToMessageID    *int64              `json:"reply_to_message_id,omitempty" db:"reply_to_message_id"`
+	CreatedAt           time.Time           `json:"created_at" db:"created_at"`
+	ContentSearchVector *string             `json:"-" db:"content_search_vector"`
+	EditedAt            *time.Time          `json:"edited_at,omitempty" db:"edited_at"`
+	DeletedAt           *time.Time          `json:"deleted_at,omitempty" db:"deleted_at"`
+	DeletedByUserID     *uuid.UUID          `json:"deleted_by_user_id,omitempty" db:"deleted_by_user_id"`
+	ClientMessageID     *string             `json:"client_message_id,omitempty" db:"client_message_id"`
+	Forwarded           bool                `json:"forwarded" db:"forwarded"`
+	ForwardCount        int                 `json:"forward_count" db:"forward_count"`
+	ExpiresAt           *time.Time          `json:"expires_at,omitempty" db:"expires_at"` // set while the chat's timer is on
+	ReplyToStatusID     *uuid.UUID          `json:"reply_to_status_id,omitempty" db:"reply_to_status_id"`
+	StatusPreview       *StatusReplyPreview `json:"status_preview,omitempty" db:"status_preview"` // set with ReplyToStatusID
 }
 
 // MessageStatusType defines the status of a message for a user.
@@ -48,10 +55,12 @@ const (
 
 // MessageStatus represents the status of a message for a specific user.
 type MessageStatus struct {
-	MessageID int64             `json:"message_id" db:"message_id"`
-	UserID    uuid.UUID         `json:"user_id" db:"user_id"`
-	Status    MessageStatusType `json:"status" db:"status"`
-	Timestamp time.Time         `json:"timestamp" db:"timestamp"`
+	MessageID   int64             `json:"message_id" db:"message_id"`
+	UserID      uuid.UUID         `json:"user_id" db:"user_id"`
+	Status      MessageStatusType `json:"status" db:"status"`
+	Timestamp   time.Time         `json:"timestamp" db:"timestamp"`
+	DeliveredAt *time.Time        `json:"delivered_at,omitempty" db:"delivered_at"`
+	ReadAt      *time.Time        `json:"read_at,omitempty" db:"read_at"`
 }
 
 // MessageReaction represents a reaction to a message.
@@ -61,3 +70,43 @@ type MessageReaction struct {
 	ReactionEmoji string    `json:"reaction_emoji" db:"reaction_emoji"`
 	CreatedAt     time.Time `json:"created_at" db:"created_at"`
 }
+
+// SystemEventType identifies what a SystemNotificationContent message announces.
+type SystemEventType string
+
+const (
+	MembersAddedEvent     SystemEventType = "members_added"
+	MemberRemovedEvent    SystemEventType = "member_removed"
+	MemberLeftEvent       SystemEventType = "member_left"
+	AdminPromotedEvent    SystemEventType = "admin_promoted"
+	AdminDemotedEvent     SystemEventType = "admin_demoted"
+	GroupInfoUpdatedEvent SystemEventType = "group_info_updated"
+	MemberJoinedEvent     SystemEventType = "member_joined" // joined through the invite link
+	InviteLinkResetEvent  SystemEventType = "invite_link_reset"
+	MessagePinnedEvent    SystemEventType = "message_pinned"
+	MessageTimerEvent     SystemEventType = "message_timer_changed"
+)
+
+// SystemNotification is the JSON payload stored as the content of a
+// SystemNotificationContent message. Clients render it in their own language.
+type SystemNotification struct {
+	Event         SystemEventType `json:"event"`
+	ActorUserID   *uuid.UUID      `json:"actor_user_id,omitempty"`
+	TargetUserIDs []uuid.UUID     `json:"target_user_ids,omitempty"`
+	Changes       []string        `json:"changes,omitempty"`       // e.g. "name", "icon", "description"
+	MessageID     *int64          `json:"message_id,omitempty"`    // the message the event refers to, e.g. a pin
+	TimerSeconds  *int            `json:"timer_seconds,omitempty"` // new disappearing-messages timer, 0 for off
+}
+
+// MaxQuoteRunes bounds the text a reply quotes of the message or status it answers.
+const MaxQuoteRunes = 100
+
+// QuoteSnippet prepares text to be quoted above a reply: runs of whitespace, line breaks
+// included, become single spaces, and text longer than MaxQuoteRunes is cut and ends in "…".
+func QuoteSnippet(text string) string {
+	text = strings.Join(strings.Fields(text), " ")
+	if runes := []rune(text); len(runes) > MaxQuoteRunes {
+		return string(runes[:MaxQuoteRunes]) + "…"
+	}
+	return text
+}
@@ -0,0 +1,26 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestQuoteSnippet(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{"whitespace collapses", "  good\n\nmorning \t all ", "good morning all"},
+		{"at the limit", strings.Repeat("ş", MaxQuoteRunes), strings.Repeat("ş", MaxQuoteRunes)},
+		{"cut after the limit", strings.Repeat("ş", MaxQuoteRunes+1), strings.Repeat("ş", MaxQuoteRunes) + "…"},
+		{"empty", " \n ", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := QuoteSnippet(tt.text); got != tt.want {
+				t.Errorf("QuoteSnippet(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
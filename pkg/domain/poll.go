@@ -8,11 +8,14 @@ import (
 
 // Poll represents a poll in a chat.
 type Poll struct {
-	ID              uuid.UUID `json:"id" db:"id"`
-	ChatID          uuid.UUID `json:"chat_id" db:"chat_id"`
-	CreatedByUserID uuid.UUID `json:"created_by_user_id" db:"created_by_user_id"`
-	QuestionText    string    `json:"question_text" db:"question_text"`
-	CreatedAt       time.Time `json:"created_at" db:"created_at"`
+	ID                   uuid.UUID  `json:"id" db:"id"`
+	ChatID               uuid.UUID  `json:"chat_id" db:"chat_id"`
+	CreatedByUserID      uuid.UUID  `json:"created_by_user_id" db:"created_by_user_id"`
+	QuestionText         string     `json:"question_text" db:"question_text"`
+	CreatedAt            time.Time  `json:"created_at" db:"created_at"`
+	AllowMultipleAnswers bool       `json:"allow_multiple_answers" db:"allow_multiple_answers"`
+	ClosedAt             *time.Time `json:"closed_at,omitempty" db:"closed_at"`
+	MessageID            *int64     `json:"message_id,omitempty" db:"message_id"` // nil once the poll message is gone
 }
 
 // PollOption represents an option in a poll.
@@ -27,4 +30,12 @@ type PollVote struct {
 	PollID   uuid.UUID `json:"poll_id" db:"poll_id"`
 	OptionID int64     `json:"option_id" db:"option_id"`
 	UserID   uuid.UUID `json:"user_id" db:"user_id"`
+	VotedAt  time.Time `json:"voted_at" db:"voted_at"`
+}
+
+// PollMessage is the JSON payload stored as the content of a PollContent message.
+// Clients fetch options and results with the poll ID.
+type PollMessage struct {
+	PollID   uuid.UUID `json:"poll_id"`
+	Question string    `json:"question"`
 }
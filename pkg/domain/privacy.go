@@ -0,0 +1,108 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PrivacySetting names one piece of information a user can restrict.
+type PrivacySetting string
+
+const (
+	LastSeenSetting     PrivacySetting = "last_seen"
+	OnlineSetting       PrivacySetting = "online"
+	ProfilePhotoSetting PrivacySetting = "profile_photo"
+	AboutSetting        PrivacySetting = "about"
+	StatusSetting       PrivacySetting = "status"
+	GroupAddSetting     PrivacySetting = "group_add" // who may add the user to groups
+)
+
+// PrivacySettingNames lists every PrivacySetting.
+var PrivacySettingNames = []PrivacySetting{LastSeenSetting, OnlineSetting, ProfilePhotoSetting, AboutSetting, StatusSetting, GroupAddSetting}
+
+// PrivacyAudience defines who a privacy setting lets through.
+type PrivacyAudience string
+
+const (
+	AudienceEveryone       PrivacyAudience = "everyone"
+	AudienceContacts       PrivacyAudience = "contacts"
+	AudienceContactsExcept PrivacyAudience = "contacts_except" // contacts minus an exception list
+	AudienceNobody         PrivacyAudience = "nobody"
+)
+
+// Valid reports whether a is a known audience.
+func (a PrivacyAudience) Valid() bool {
+	switch a {
+	case AudienceEveryone, AudienceContacts, AudienceContactsExcept, AudienceNobody:
+		return true
+	}
+	return false
+}
+
+// PrivacySettings are a user's privacy choices.
+type PrivacySettings struct {
+	UserID     uuid.UUID                          `json:"user_id" db:"user_id"`
+	Audiences  map[PrivacySetting]PrivacyAudience `json:"audiences"`
+	Exceptions map[PrivacySetting][]uuid.UUID     `json:"exceptions,omitempty"` // used by AudienceContactsExcept
+	// ReadReceipts off hides the user's status views from posters.
+	ReadReceipts bool      `json:"read_receipts" db:"read_receipts"`
+	UpdatedAt    time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// DefaultPrivacySettings returns the settings of a user who never changed them.
+func DefaultPrivacySettings(userID uuid.UUID) *PrivacySettings {
+	return &PrivacySettings{
+		UserID: userID,
+		Audiences: map[PrivacySetting]PrivacyAudience{
+			LastSeenSetting:     AudienceEveryone,
+			OnlineSetting:       AudienceEveryone,
+			ProfilePhotoSetting: AudienceEveryone,
+			AboutSetting:        AudienceEveryone,
+			StatusSetting:       AudienceContacts,
+			GroupAddSetting:     AudienceEveryone,
+		},
+		Exceptions:   map[PrivacySetting][]uuid.UUID{},
+		ReadReceipts: true,
+	}
+}
+
+// Audience returns who the setting lets through.
+func (p *PrivacySettings) Audience(setting PrivacySetting) PrivacyAudience {
+	if a, ok := p.Audiences[setting]; ok {
+		return a
+	}
+	return DefaultPrivacySettings(p.UserID).Audiences[setting]
+}
+
+// NeedsContact reports whether deciding the setting depends on the viewer being a contact.
+func (p *PrivacySettings) NeedsContact(setting PrivacySetting) bool {
+	a := p.Audience(setting)
+	return a == AudienceContacts || a == AudienceContactsExcept
+}
+
+// Allows reports whether viewerID may see (or, for GroupAddSetting, do) what the setting
+// covers. isContact tells whether the viewer is in the owner's contacts. Owners always see
+// their own information.
+func (p *PrivacySettings) Allows(setting PrivacySetting, viewerID uuid.UUID, isContact bool) bool {
+	if viewerID == p.UserID {
+		return true
+	}
+	switch p.Audience(setting) {
+	case AudienceEveryone:
+		return true
+	case AudienceContacts:
+		return isContact
+	case AudienceContactsExcept:
+		if !isContact {
+			return false
+		}
+		for _, id := range p.Exceptions[setting] {
+			if id == viewerID {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
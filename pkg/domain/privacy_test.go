@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestPrivacySettingsAllows(t *testing.T) {
+	owner, viewer, excluded := uuid.New(), uuid.New(), uuid.New()
+	settings := func(a PrivacyAudience) *PrivacySettings {
+		p := DefaultPrivacySettings(owner)
+		p.Audiences[LastSeenSetting] = a
+		p.Exceptions[LastSeenSetting] = []uuid.UUID{excluded}
+		return p
+	}
+	tests := []struct {
+		name      string
+		audience  PrivacyAudience
+		viewer    uuid.UUID
+		isContact bool
+		want      bool
+	}{
+		{"everyone lets strangers through", AudienceEveryone, viewer, false, true},
+		{"contacts lets contacts through", AudienceContacts, viewer, true, true},
+		{"contacts keeps strangers out", AudienceContacts, viewer, false, false},
+		{"contacts except lets other contacts through", AudienceContactsExcept, viewer, true, true},
+		{"contacts except keeps listed contacts out", AudienceContactsExcept, excluded, true, false},
+		{"contacts except keeps strangers out", AudienceContactsExcept, viewer, false, false},
+		{"nobody keeps contacts out", AudienceNobody, viewer, true, false},
+		{"owner always sees their own", AudienceNobody, owner, false, true},
+		{"unknown audience denies", "friends", viewer, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := settings(tt.audience).Allows(LastSeenSetting, tt.viewer, tt.isContact); got != tt.want {
+				t.Errorf("Allows = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPrivacySettingsDefaults(t *testing.T) {
+	owner := uuid.New()
+	p := &PrivacySettings{UserID: owner} // a row without per-setting choices
+	if got := p.Audience(StatusSetting); got != AudienceContacts {
+		t.Errorf("Audience(status) = %q, want %q", got, AudienceContacts)
+	}
+	if got := p.Audience(LastSeenSetting); got != AudienceEveryone {
+		t.Errorf("Audience(last_seen) = %q, want %q", got, AudienceEveryone)
+	}
+	if p.Allows(StatusSetting, uuid.New(), false) {
+		t.Error("default status setting let a stranger through")
+	}
+}
+
+func TestPrivacySettingsNeedsContact(t *testing.T) {
+	tests := []struct {
+		audience PrivacyAudience
+		want     bool
+	}{
+		{AudienceEveryone, false},
+		{AudienceContacts, true},
+		{AudienceContactsExcept, true},
+		{AudienceNobody, false},
+	}
+	for _, tt := range tests {
+		p := DefaultPrivacySettings(uuid.New())
+		p.Audiences[AboutSetting] = tt.audience
+		if got := p.NeedsContact(AboutSetting); got != tt.want {
+			t.Errorf("NeedsContact with %q = %v, want %v", tt.audience, got, tt.want)
+		}
+	}
+}
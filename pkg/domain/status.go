@@ -15,14 +15,52 @@ const (
 	VideoStatus StatusContentType = "video"
 )
 
-// StatusUpdate represents a user's status update (story).
+// StatusAudienceMode defines who a status is shared with.
+type StatusAudienceMode string
+
+const (
+	AudienceAllContacts    StatusAudienceMode = "contacts"
+	AudienceAllContactsBut StatusAudienceMode = "contacts_except" // all contacts except UserIDs
+	AudienceOnlyShareWith  StatusAudienceMode = "only_share_with" // only the contacts in UserIDs
+)
+
+// Valid reports whether m is a known audience mode.
+func (m StatusAudienceMode) Valid() bool {
+	switch m {
+	case AudienceAllContacts, AudienceAllContactsBut, AudienceOnlyShareWith:
+		return true
+	}
+	return false
+}
+
+// StatusAudience is the audience of a status, fixed when it is posted.
+type StatusAudience struct {
+	Mode    StatusAudienceMode `json:"mode" db:"mode"`
+	UserIDs []uuid.UUID        `json:"user_ids,omitempty"`
+}
+
+// StatusUpdate represents a user's status update (story). ContentOrURL holds the text of
+// text statuses and the media URL of image and video statuses.
 type StatusUpdate struct {
 	ID           uuid.UUID         `json:"id" db:"id"`
 	UserID       uuid.UUID         `json:"user_id" db:"user_id"`
 	ContentType  StatusContentType `json:"content_type" db:"content_type"`
 	ContentOrURL string            `json:"content_or_url" db:"content_or_url"`
+	Caption      *string           `json:"caption,omitempty" db:"caption"` // image and video statuses only
 	CreatedAt    time.Time         `json:"created_at" db:"created_at"`
 	ExpiresAt    time.Time         `json:"expires_at" db:"expires_at"`
+	Audience     *StatusAudience   `json:"audience,omitempty"` // loaded for the poster's own statuses only
+}
+
+// StatusReplyPreview is the snapshot of a status kept on a chat message that replies to
+// it, so the quote still renders after the status expires.
+type StatusReplyPreview struct {
+	StatusID    uuid.UUID         `json:"status_id"`
+	UserID      uuid.UUID         `json:"user_id"` // the poster
+	ContentType StatusContentType `json:"content_type"`
+	Snippet     string            `json:"snippet,omitempty"`   // start of the text or caption
+	MediaURL    string            `json:"media_url,omitempty"` // thumbnail source of image and video statuses
+	CreatedAt   time.Time         `json:"created_at"`
 }
 
 // StatusView represents a view of a status update by a user.
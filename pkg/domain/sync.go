@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SyncEventKind identifies what changed in a SyncEvent.
+type SyncEventKind string
+
+const (
+	SyncMessage     SyncEventKind = "message"       // a message was stored
+	SyncEdit        SyncEventKind = "edit"          // a message's content was edited
+	SyncDelete      SyncEventKind = "delete"        // a message was deleted for everyone
+	SyncDeleteForMe SyncEventKind = "delete_for_me" // the user hid a message for themselves
+	SyncReceipt     SyncEventKind = "receipt"       // someone received or read the user's message
+)
+
+// SyncEvent is one entry of the ordered chat change log devices catch up from.
+type SyncEvent struct {
+	Seq       int64           `json:"seq" db:"seq"`
+	ChatID    uuid.UUID       `json:"chat_id" db:"chat_id"`
+	Kind      SyncEventKind   `json:"kind" db:"kind"`
+	MessageID int64           `json:"message_id" db:"message_id"`
+	Message   *Message        `json:"message,omitempty"` // the message as it is now, for SyncMessage
+	Payload   json.RawMessage `json:"payload,omitempty" db:"payload"`
+	CreatedAt time.Time       `json:"created_at" db:"created_at"`
+}
+
+// SyncEditPayload is the payload of a SyncEdit event.
+type SyncEditPayload struct {
+	Content  string    `json:"content"`
+	EditedAt time.Time `json:"edited_at"`
+}
+
+// SyncDeletePayload is the payload of SyncDelete and SyncDeleteForMe events.
+type SyncDeletePayload struct {
+	DeletedByUserID *uuid.UUID `json:"deleted_by_user_id,omitempty"` // nil when the message expired
+	DeletedAt       time.Time  `json:"deleted_at"`
+}
+
+// SyncReceiptPayload is the payload of a SyncReceipt event.
+type SyncReceiptPayload struct {
+	ReaderUserID uuid.UUID         `json:"reader_user_id"`
+	Status       MessageStatusType `json:"status"`
+	At           time.Time         `json:"at"`
+}
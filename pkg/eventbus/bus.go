@@ -0,0 +1,88 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+)
+
+// ErrClosed is returned when publishing to or subscribing on a closed bus.
+var ErrClosed = errors.New("eventbus: closed")
+
+// Message is one event on the bus.
+type Message struct {
+	Topic string
+	// Key picks the partition: messages with the same key are delivered in publish order.
+	// Chat events use the chat ID. Messages without a key are spread evenly.
+	Key     string
+	Headers map[string]string
+	Value   []byte
+}
+
+// Handler processes one message. A message counts as consumed only once its handler
+// returns nil; on error it is handed to the handler again, so handlers must tolerate
+// duplicates. Malformed messages should be dropped rather than failed, since retrying
+// them cannot help and holds up the rest of the partition.
+type Handler func(ctx context.Context, msg Message) error
+
+// Publisher publishes events.
+type Publisher interface {
+	// Publish returns once the bus has accepted msg.
+	Publish(ctx context.Context, msg Message) error
+}
+
+// BatchPublisher is a Publisher that also accepts many messages in one call.
+type BatchPublisher interface {
+	Publisher
+	// PublishBatch returns once the bus has accepted every message of msgs. On error some
+	// of them may have been accepted anyway.
+	PublishBatch(ctx context.Context, msgs []Message) error
+}
+
+// PublishAll publishes msgs in order, in a single call when p is a BatchPublisher.
+func PublishAll(ctx context.Context, p Publisher, msgs []Message) error {
+	if bp, ok := p.(BatchPublisher); ok {
+		return bp.PublishBatch(ctx, msgs)
+	}
+	for _, msg := range msgs {
+		if err := p.Publish(ctx, msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Subscriber delivers events to handlers. Each subscriber belongs to a consumer group:
+// every group gets every message of the topics it subscribes to, and within a group each
+// message goes to a single handler, the same one for every message with the same key.
+type Subscriber interface {
+	Subscribe(topic string, handler Handler) error
+}
+
+// Retry delays of failed handlers.
+const (
+	minRetryDelay = 100 * time.Millisecond
+	maxRetryDelay = 10 * time.Second
+)
+
+// handleWithRetry runs handler on msg until it succeeds, backing off between attempts. It
+// only gives up when ctx is done, returning ctx's error.
+func handleWithRetry(ctx context.Context, handler Handler, msg Message) error {
+	delay := minRetryDelay
+	for {
+		err := handler(ctx, msg)
+		if err == nil {
+			return nil
+		}
+		log.Printf("eventbus: handler for topic %s failed, retrying in %s: %v", msg.Topic, delay, err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		if delay *= 2; delay > maxRetryDelay {
+			delay = maxRetryDelay
+		}
+	}
+}
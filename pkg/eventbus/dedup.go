@@ -0,0 +1,70 @@
+package eventbus
+
+import (
+	"container/list"
+	"context"
+	"sync"
+)
+
+// SkipDuplicates wraps handler so that a message carrying an event ID (HeaderEventID) the
+// handler already consumed is skipped instead of applied again. It remembers the last
+// capacity IDs consumed, so wrap each subscription, i.e. each consumer group, separately.
+// An ID counts as consumed only once handler returned nil for it; messages without an
+// event ID are always handled.
+func SkipDuplicates(capacity int, handler Handler) Handler {
+	seen := newEventIDCache(capacity)
+	return func(ctx context.Context, msg Message) error {
+		id := msg.Headers[HeaderEventID]
+		if id == "" {
+			return handler(ctx, msg)
+		}
+		if seen.contains(id) {
+			return nil
+		}
+		if err := handler(ctx, msg); err != nil {
+			return err
+		}
+		seen.add(id)
+		return nil
+	}
+}
+
+// eventIDCache is a bounded set of event IDs that evicts the least recently used.
+type eventIDCache struct {
+	mu       sync.Mutex
+	capacity int
+	order    *list.List // of string, most recent first
+	ids      map[string]*list.Element
+}
+
+func newEventIDCache(capacity int) *eventIDCache {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &eventIDCache{capacity: capacity, order: list.New(), ids: map[string]*list.Element{}}
+}
+
+func (c *eventIDCache) contains(id string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.ids[id]
+	if ok {
+		c.order.MoveToFront(e)
+	}
+	return ok
+}
+
+func (c *eventIDCache) add(id string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.ids[id]; ok {
+		c.order.MoveToFront(e)
+		return
+	}
+	c.ids[id] = c.order.PushFront(id)
+	if c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.ids, oldest.Value.(string))
+	}
+}
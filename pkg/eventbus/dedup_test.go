@@ -0,0 +1,47 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestSkipDuplicatesHandlesEachEventIDOnce(t *testing.T) {
+	var handled []string
+	fail := true
+	h := SkipDuplicates(2, func(_ context.Context, msg Message) error {
+		if msg.Headers[HeaderEventID] == "b" && fail {
+			fail = false
+			return errors.New("try again")
+		}
+		handled = append(handled, msg.Headers[HeaderEventID])
+		return nil
+	})
+	msg := func(id string) Message {
+		return Message{Topic: "t", Headers: map[string]string{HeaderEventID: id}}
+	}
+
+	ctx := context.Background()
+	for _, id := range []string{"a", "a", "b"} {
+		_ = h(ctx, msg(id))
+	}
+	// b failed, so its redelivery is handled; a is still remembered.
+	for _, id := range []string{"b", "a", "b", "c"} {
+		if err := h(ctx, msg(id)); err != nil {
+			t.Fatalf("handle %s: %v", id, err)
+		}
+	}
+	// Remembering two IDs, c pushed out a, the least recently seen, which is handled again.
+	if err := h(ctx, msg("a")); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"a", "b", "c", "a"}
+	if len(handled) != len(want) {
+		t.Fatalf("handled %v, want %v", handled, want)
+	}
+	for i := range want {
+		if handled[i] != want[i] {
+			t.Fatalf("handled %v, want %v", handled, want)
+		}
+	}
+}
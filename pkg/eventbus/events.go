@@ -0,0 +1,23 @@
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// HeaderContentType names the header holding the media type of a message's value.
+const HeaderContentType = "content-type"
+
+// PublishJSON encodes payload as JSON and publishes it on topic under key.
+func PublishJSON(ctx context.Context, p Publisher, topic string, key string, payload interface{}) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	return p.Publish(ctx, Message{
+		Topic:   topic,
+		Key:     key,
+		Headers: map[string]string{HeaderContentType: "application/json"},
+		Value:   data,
+	})
+}
@@ -1,11 +1,169 @@
 package eventbus
 
-// Publisher is an interface for publishing events.
-type Publisher interface {
-	Publish(topic string, message []byte) error
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// KafkaConfig configures KafkaPublisher and KafkaSubscriber.
+type KafkaConfig struct {
+	Brokers []string
+	// GroupID is the consumer group of a KafkaSubscriber.
+	GroupID string
+	// StartAtLatest makes a group without committed offsets start with new messages
+	// instead of the oldest retained ones.
+	StartAtLatest bool
+}
+
+// KafkaPublisher publishes to Kafka. Messages are partitioned by key, and Publish waits
+// until every in-sync replica has them.
+type KafkaPublisher struct {
+	writer *kafka.Writer
+}
+
+func NewKafkaPublisher(cfg KafkaConfig) (*KafkaPublisher, error) {
+	if len(cfg.Brokers) == 0 {
+		return nil, errors.New("eventbus: no Kafka brokers configured")
+	}
+	return &KafkaPublisher{writer: &kafka.Writer{
+		Addr:                   kafka.TCP(cfg.Brokers...),
+		Balancer:               &kafka.Hash{},
+		RequiredAcks:           kafka.RequireAll,
+		AllowAutoTopicCreation: true,
+		// Publish sends one message and waits for it; don't hold it back for a batch.
+		BatchTimeout: 5 * time.Millisecond,
+	}}, nil
+}
+
+func (p *KafkaPublisher) Publish(ctx context.Context, msg Message) error {
+	return p.writer.WriteMessages(ctx, toKafkaMessage(msg))
+}
+
+// PublishBatch writes msgs in one call, letting the writer batch them per partition.
+func (p *KafkaPublisher) PublishBatch(ctx context.Context, msgs []Message) error {
+	kms := make([]kafka.Message, 0, len(msgs))
+	for _, msg := range msgs {
+		kms = append(kms, toKafkaMessage(msg))
+	}
+	return p.writer.WriteMessages(ctx, kms...)
+}
+
+func toKafkaMessage(msg Message) kafka.Message {
+	km := kafka.Message{Topic: msg.Topic, Value: msg.Value}
+	if msg.Key != "" {
+		km.Key = []byte(msg.Key)
+	}
+	for k, v := range msg.Headers {
+		km.Headers = append(km.Headers, kafka.Header{Key: k, Value: []byte(v)})
+	}
+	return km
 }
 
-// Subscriber is an interface for subscribing to events.
-type Subscriber interface {
-	Subscribe(topic string, handler func(message []byte)) error
+// Close flushes pending writes and closes the connections.
+func (p *KafkaPublisher) Close() error {
+	return p.writer.Close()
+}
+
+// KafkaSubscriber consumes Kafka topics as a consumer group. Each message's offset is
+// committed only after its handler succeeded, so delivery is at least once.
+type KafkaSubscriber struct {
+	cfg    KafkaConfig
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu      sync.Mutex
+	readers []*kafka.Reader
+	closed  bool
+}
+
+func NewKafkaSubscriber(cfg KafkaConfig) (*KafkaSubscriber, error) {
+	if len(cfg.Brokers) == 0 {
+		return nil, errors.New("eventbus: no Kafka brokers configured")
+	}
+	if cfg.GroupID == "" {
+		return nil, errors.New("eventbus: Kafka subscriber needs a group ID")
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &KafkaSubscriber{cfg: cfg, ctx: ctx, cancel: cancel}, nil
+}
+
+func (s *KafkaSubscriber) Subscribe(topic string, handler Handler) error {
+	start := kafka.FirstOffset
+	if s.cfg.StartAtLatest {
+		start = kafka.LastOffset
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return ErrClosed
+	}
+	r := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:     s.cfg.Brokers,
+		GroupID:     s.cfg.GroupID,
+		Topic:       topic,
+		StartOffset: start,
+		// Commit synchronously, right after each handled message.
+		CommitInterval: 0,
+	})
+	s.readers = append(s.readers, r)
+	s.wg.Add(1)
+	go s.consume(r, handler)
+	return nil
+}
+
+// consume handles the reader's messages one at a time, which keeps each partition in order.
+func (s *KafkaSubscriber) consume(r *kafka.Reader, handler Handler) {
+	defer s.wg.Done()
+	topic := r.Config().Topic
+	for {
+		m, err := r.FetchMessage(s.ctx)
+		if err != nil {
+			if s.ctx.Err() != nil {
+				return
+			}
+			log.Printf("eventbus: fetch from topic %s: %v", topic, err)
+			select {
+			case <-s.ctx.Done():
+				return
+			case <-time.After(time.Second):
+			}
+			continue
+		}
+		msg := Message{Topic: m.Topic, Key: string(m.Key), Value: m.Value}
+		if len(m.Headers) > 0 {
+			msg.Headers = make(map[string]string, len(m.Headers))
+			for _, h := range m.Headers {
+				msg.Headers[h.Key] = string(h.Value)
+			}
+		}
+		if err := handleWithRetry(s.ctx, handler, msg); err != nil {
+			return // closing; the message stays uncommitted and is redelivered
+		}
+		if err := r.CommitMessages(s.ctx, m); err != nil && s.ctx.Err() == nil {
+			log.Printf("eventbus: commit offset %d of topic %s partition %d: %v", m.Offset, m.Topic, m.Partition, err)
+		}
+	}
+}
+
+// Close stops consuming, waits for running handlers to return and leaves the group.
+func (s *KafkaSubscriber) Close() error {
+	// Refuse new subscriptions first, so no reader starts after the wait below.
+	s.mu.Lock()
+	s.closed = true
+	readers := s.readers
+	s.mu.Unlock()
+
+	s.cancel()
+	s.wg.Wait()
+	var errs []error
+	for _, r := range readers {
+		errs = append(errs, r.Close())
+	}
+	return errors.Join(errs...)
 }
@@ -0,0 +1,143 @@
+package eventbus
+
+import (
+	"context"
+	"hash/fnv"
+	"sync"
+	"sync/atomic"
+)
+
+// defaultMemoryQueueSize is how many messages may wait for one handler before Publish blocks.
+const defaultMemoryQueueSize = 1024
+
+// MemoryBus is an in-process Publisher with Subscribers that behave like Kafka consumer
+// groups, for tests and single-binary runs. Delivery is asynchronous and partitioned by
+// key, and failed handlers are retried until they succeed. Unlike Kafka, nothing survives
+// a restart and groups only see messages published after they subscribed.
+type MemoryBus struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu     sync.RWMutex
+	groups map[string]map[string]*memoryGroup // topic -> group ID -> handlers
+	closed bool
+}
+
+// memoryGroup is one consumer group's handlers of a topic; each plays a partition.
+type memoryGroup struct {
+	members []chan Message
+	next    uint32 // round robin over members for messages without a key
+}
+
+func NewMemoryBus() *MemoryBus {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &MemoryBus{ctx: ctx, cancel: cancel, groups: map[string]map[string]*memoryGroup{}}
+}
+
+// Group returns a Subscriber in consumer group groupID.
+func (b *MemoryBus) Group(groupID string) Subscriber {
+	return &memorySubscriber{bus: b, groupID: groupID}
+}
+
+// Publish queues msg for one handler of each group subscribed to its topic. It blocks
+// while that handler's queue is full.
+func (b *MemoryBus) Publish(ctx context.Context, msg Message) error {
+	// Pick the queues under the lock but send after releasing it: a send may block on a
+	// full queue, and holding the lock then would stall Subscribe and Close.
+	b.mu.RLock()
+	if b.closed {
+		b.mu.RUnlock()
+		return ErrClosed
+	}
+	queues := make([]chan Message, 0, len(b.groups[msg.Topic]))
+	for _, g := range b.groups[msg.Topic] {
+		queues = append(queues, g.partition(msg.Key))
+	}
+	b.mu.RUnlock()
+
+	for _, q := range queues {
+		select {
+		case q <- msg:
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-b.ctx.Done():
+			return ErrClosed
+		}
+	}
+	return nil
+}
+
+// partition picks the handler queue for key the way Kafka's hash balancer picks a
+// partition.
+func (g *memoryGroup) partition(key string) chan Message {
+	n := uint32(len(g.members))
+	if key == "" {
+		return g.members[(atomic.AddUint32(&g.next, 1)-1)%n]
+	}
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	p := int32(h.Sum32()) % int32(n)
+	if p < 0 {
+		p = -p
+	}
+	return g.members[p]
+}
+
+func (b *MemoryBus) subscribe(topic string, groupID string, handler Handler) error {
+	queue := make(chan Message, defaultMemoryQueueSize)
+
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return ErrClosed
+	}
+	groups := b.groups[topic]
+	if groups == nil {
+		groups = map[string]*memoryGroup{}
+		b.groups[topic] = groups
+	}
+	g := groups[groupID]
+	if g == nil {
+		g = &memoryGroup{}
+		groups[groupID] = g
+	}
+	g.members = append(g.members, queue)
+	b.wg.Add(1)
+	b.mu.Unlock()
+
+	go func() {
+		defer b.wg.Done()
+		for {
+			select {
+			case <-b.ctx.Done():
+				return
+			case msg := <-queue:
+				if err := handleWithRetry(b.ctx, handler, msg); err != nil {
+					return
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// Close stops delivery and waits for running handlers to return. Queued messages are
+// dropped.
+func (b *MemoryBus) Close() error {
+	b.cancel()
+	b.mu.Lock()
+	b.closed = true
+	b.mu.Unlock()
+	b.wg.Wait()
+	return nil
+}
+
+type memorySubscriber struct {
+	bus     *MemoryBus
+	groupID string
+}
+
+func (s *memorySubscriber) Subscribe(topic string, handler Handler) error {
+	return s.bus.subscribe(topic, s.groupID, handler)
+}
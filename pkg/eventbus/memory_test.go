@@ -0,0 +1,180 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// collector records what each handler of a test received.
+type collector struct {
+	mu  sync.Mutex
+	got map[string][]string // handler name -> message values, in arrival order
+	wg  sync.WaitGroup
+}
+
+func newCollector(expected int) *collector {
+	c := &collector{got: map[string][]string{}}
+	c.wg.Add(expected)
+	return c
+}
+
+func (c *collector) handler(name string) Handler {
+	return func(_ context.Context, msg Message) error {
+		c.mu.Lock()
+		c.got[name] = append(c.got[name], string(msg.Value))
+		c.mu.Unlock()
+		c.wg.Done()
+		return nil
+	}
+}
+
+// wait fails the test unless every expected message arrived within a second.
+func (c *collector) wait(t *testing.T) {
+	t.Helper()
+	done := make(chan struct{})
+	go func() { c.wg.Wait(); close(done) }()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for deliveries")
+	}
+}
+
+func TestMemoryBusGroups(t *testing.T) {
+	bus := NewMemoryBus()
+	defer bus.Close()
+
+	const messages = 50
+	// Group a has two handlers that share the topic; group b has one that sees all of it.
+	c := newCollector(2 * messages)
+	a := bus.Group("a")
+	for _, name := range []string{"a1", "a2"} {
+		if err := a.Subscribe("t", c.handler(name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := bus.Group("b").Subscribe("t", c.handler("b")); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Group("other").Subscribe("elsewhere", c.handler("other")); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	for i := 0; i < messages; i++ {
+		key := fmt.Sprintf("chat-%d", i%5)
+		if err := bus.Publish(ctx, Message{Topic: "t", Key: key, Value: []byte(fmt.Sprintf("%s/%d", key, i))}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	c.wait(t)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if n := len(c.got["b"]); n != messages {
+		t.Errorf("group b got %d messages, want %d", n, messages)
+	}
+	if n := len(c.got["a1"]) + len(c.got["a2"]); n != messages {
+		t.Errorf("group a got %d messages, want %d", n, messages)
+	}
+	if n := len(c.got["other"]); n != 0 {
+		t.Errorf("subscriber of another topic got %d messages", n)
+	}
+	// Within a group, every message of a key goes to the same handler, in order.
+	owner := map[string]string{}
+	last := map[string]int{}
+	for _, name := range []string{"a1", "a2"} {
+		for _, v := range c.got[name] {
+			key, seq, _ := strings.Cut(v, "/")
+			i, err := strconv.Atoi(seq)
+			if err != nil {
+				t.Fatalf("parse %q: %v", v, err)
+			}
+			if o, ok := owner[key]; ok && o != name {
+				t.Errorf("key %s handled by both %s and %s", key, o, name)
+			}
+			owner[key] = name
+			if prev, ok := last[key]; ok && i < prev {
+				t.Errorf("key %s: message %d after %d", key, i, prev)
+			}
+			last[key] = i
+		}
+	}
+}
+
+func TestMemoryBusRedeliversUntilHandled(t *testing.T) {
+	bus := NewMemoryBus()
+	defer bus.Close()
+
+	var mu sync.Mutex
+	attempts := 0
+	done := make(chan struct{})
+	err := bus.Group("g").Subscribe("t", func(_ context.Context, msg Message) error {
+		mu.Lock()
+		defer mu.Unlock()
+		attempts++
+		if attempts < 3 {
+			return errors.New("not yet")
+		}
+		close(done)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Publish(context.Background(), Message{Topic: "t", Key: "k", Value: []byte("v")}); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("message was not redelivered until handled")
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if attempts != 3 {
+		t.Errorf("handler ran %d times, want 3", attempts)
+	}
+}
+
+func TestMemoryBusOnlyDeliversAfterSubscribe(t *testing.T) {
+	bus := NewMemoryBus()
+	defer bus.Close()
+
+	ctx := context.Background()
+	if err := bus.Publish(ctx, Message{Topic: "t", Value: []byte("early")}); err != nil {
+		t.Fatal(err)
+	}
+	c := newCollector(1)
+	if err := bus.Group("g").Subscribe("t", c.handler("g")); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Publish(ctx, Message{Topic: "t", Value: []byte("late")}); err != nil {
+		t.Fatal(err)
+	}
+	c.wait(t)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if got := c.got["g"]; len(got) != 1 || got[0] != "late" {
+		t.Errorf("got %v, want [late]", got)
+	}
+}
+
+func TestMemoryBusClose(t *testing.T) {
+	bus := NewMemoryBus()
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Publish(context.Background(), Message{Topic: "t"}); !errors.Is(err, ErrClosed) {
+		t.Errorf("Publish after Close: err = %v, want ErrClosed", err)
+	}
+	if err := bus.Group("g").Subscribe("t", func(context.Context, Message) error { return nil }); !errors.Is(err, ErrClosed) {
+		t.Errorf("Subscribe after Close: err = %v, want ErrClosed", err)
+	}
+}
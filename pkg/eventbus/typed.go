@@ -0,0 +1,180 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	pb "github.com/dykethecreator/GoApp/proto"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// Topics of the typed domain events of proto/events.proto. Chat events are keyed by chat
+// ID, status and user events by user ID.
+const (
+	TopicChatEvents   = "events.chat"
+	TopicStatusEvents = "events.status"
+	TopicUserEvents   = "events.users"
+)
+
+// Headers of typed events. They repeat envelope fields so consumers can route or drop
+// messages without decoding them.
+const (
+	HeaderEventID      = "event-id"
+	HeaderEventType    = "event-type"
+	HeaderEventVersion = "event-version"
+)
+
+// ContentTypeEnvelope is the content type of messages holding an EventEnvelope.
+const ContentTypeEnvelope = "application/x-protobuf; messageType=events.EventEnvelope"
+
+// ErrUnknownEvent is returned for events missing from the catalog.
+var ErrUnknownEvent = errors.New("eventbus: unknown event type")
+
+// eventVersions is the catalog of domain events and their current schema versions. Bump
+// a version when an event changes in a way consumers built for the old one cannot ignore;
+// such consumers skip the newer events.
+var eventVersions = map[protoreflect.FullName]int32{
+	"events.MessageSent":        1,
+	"events.MessageEdited":      1,
+	"events.MessageDeleted":     1,
+	"events.ReceiptUpdated":     1,
+	"events.ReactionChanged":    1,
+	"events.PollUpdated":        1,
+	"events.MemberAdded":        1,
+	"events.StatusPosted":       1,
+	"events.UserProfileChanged": 1,
+	"events.DeviceRevoked":      1,
+}
+
+// NewEnvelope wraps event, one of the catalog's types, in an envelope with a new event ID.
+// producer names the publishing service.
+func NewEnvelope(producer string, event proto.Message, occurredAt time.Time) (*pb.EventEnvelope, error) {
+	name := event.ProtoReflect().Descriptor().FullName()
+	version, ok := eventVersions[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownEvent, name)
+	}
+	payload, err := proto.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.EventEnvelope{
+		EventId:    uuid.NewString(),
+		Type:       string(name),
+		Version:    version,
+		OccurredAt: occurredAt.UTC().Format(time.RFC3339Nano),
+		Producer:   producer,
+		Payload:    payload,
+	}, nil
+}
+
+// DecodeEvent returns the event inside env as its generated type, e.g. *pb.MessageSent.
+func DecodeEvent(env *pb.EventEnvelope) (proto.Message, error) {
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(env.GetType()))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownEvent, env.GetType())
+	}
+	event := mt.New().Interface()
+	if err := proto.Unmarshal(env.GetPayload(), event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// PublishEnvelope publishes env on topic under key.
+func PublishEnvelope(ctx context.Context, p Publisher, topic string, key string, env *pb.EventEnvelope) error {
+	msg, err := EnvelopeMessage(topic, key, env)
+	if err != nil {
+		return err
+	}
+	return p.Publish(ctx, msg)
+}
+
+// EnvelopeMessage returns the bus message carrying env on topic under key.
+func EnvelopeMessage(topic string, key string, env *pb.EventEnvelope) (Message, error) {
+	data, err := proto.Marshal(env)
+	if err != nil {
+		return Message{}, err
+	}
+	return Message{
+		Topic: topic,
+		Key:   key,
+		Headers: map[string]string{
+			HeaderContentType:  ContentTypeEnvelope,
+			HeaderEventID:      env.GetEventId(),
+			HeaderEventType:    env.GetType(),
+			HeaderEventVersion: strconv.Itoa(int(env.GetVersion())),
+		},
+		Value: data,
+	}, nil
+}
+
+// PublishEvent wraps event in a new envelope and publishes it on topic under key.
+func PublishEvent(ctx context.Context, p Publisher, topic string, key string, producer string, event proto.Message) error {
+	env, err := NewEnvelope(producer, event, time.Now())
+	if err != nil {
+		return err
+	}
+	return PublishEnvelope(ctx, p, topic, key, env)
+}
+
+// EventHandler handles one typed event along with its envelope.
+type EventHandler[T proto.Message] func(ctx context.Context, env *pb.EventEnvelope, event T) error
+
+// EventMux dispatches the typed events of a topic to a handler per event type. Subscribe
+// its Handle method once per topic: several subscriptions in one consumer group would
+// split the topic's messages between them.
+type EventMux struct {
+	handlers map[protoreflect.FullName]func(ctx context.Context, env *pb.EventEnvelope) error
+}
+
+func NewEventMux() *EventMux {
+	return &EventMux{handlers: map[protoreflect.FullName]func(ctx context.Context, env *pb.EventEnvelope) error{}}
+}
+
+// HandleEvent registers handler for events of type T, replacing any earlier handler of
+// that type. Register handlers before subscribing the mux.
+func HandleEvent[T proto.Message](m *EventMux, handler EventHandler[T]) {
+	var zero T
+	desc := zero.ProtoReflect().Descriptor()
+	m.handlers[desc.FullName()] = func(ctx context.Context, env *pb.EventEnvelope) error {
+		event := zero.ProtoReflect().New().Interface().(T)
+		if err := proto.Unmarshal(env.GetPayload(), event); err != nil {
+			log.Printf("eventbus: drop malformed %s event %s: %v", env.GetType(), env.GetEventId(), err)
+			return nil
+		}
+		return handler(ctx, env, event)
+	}
+}
+
+// Handle is the mux's Handler. Events without a registered handler are skipped, as are
+// malformed messages and events newer than the version this binary knows.
+func (m *EventMux) Handle(ctx context.Context, msg Message) error {
+	if t, ok := msg.Headers[HeaderEventType]; ok {
+		if _, handled := m.handlers[protoreflect.FullName(t)]; !handled {
+			return nil // skip without decoding
+		}
+	}
+	var env pb.EventEnvelope
+	if err := proto.Unmarshal(msg.Value, &env); err != nil {
+		log.Printf("eventbus: drop malformed envelope on topic %s: %v", msg.Topic, err)
+		return nil
+	}
+	name := protoreflect.FullName(env.GetType())
+	handle, ok := m.handlers[name]
+	if !ok {
+		return nil
+	}
+	if env.GetVersion() > eventVersions[name] {
+		log.Printf("eventbus: skip %s event %s of version %d, newer than %d", name, env.GetEventId(), env.GetVersion(), eventVersions[name])
+		return nil
+	}
+	return handle(ctx, &env)
+}
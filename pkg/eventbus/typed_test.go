@@ -0,0 +1,177 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	pb "github.com/dykethecreator/GoApp/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestNewEnvelopeAndDecodeEvent(t *testing.T) {
+	at := time.Date(2024, 3, 1, 10, 0, 0, 0, time.FixedZone("CET", 3600))
+	sent := &pb.MessageSent{ChatId: "chat-1", MessageId: 7, Content: "hi", RecipientUserIds: []string{"a", "b"}}
+	env, err := NewEnvelope("chat_service", sent, at)
+	if err != nil {
+		t.Fatalf("NewEnvelope: %v", err)
+	}
+	if env.EventId == "" {
+		t.Error("envelope has no event ID")
+	}
+	if env.Type != "events.MessageSent" || env.Version != 1 || env.Producer != "chat_service" {
+		t.Errorf("envelope = type %q version %d producer %q", env.Type, env.Version, env.Producer)
+	}
+	if env.OccurredAt != "2024-03-01T09:00:00Z" {
+		t.Errorf("OccurredAt = %q, want UTC RFC3339", env.OccurredAt)
+	}
+
+	again, err := NewEnvelope("chat_service", sent, at)
+	if err != nil {
+		t.Fatalf("NewEnvelope: %v", err)
+	}
+	if again.EventId == env.EventId {
+		t.Error("two envelopes share an event ID")
+	}
+
+	got, err := DecodeEvent(env)
+	if err != nil {
+		t.Fatalf("DecodeEvent: %v", err)
+	}
+	if !proto.Equal(got, sent) {
+		t.Errorf("DecodeEvent = %v, want %v", got, sent)
+	}
+}
+
+func TestNewEnvelopeRejectsUnknownEvents(t *testing.T) {
+	_, err := NewEnvelope("test", &pb.EventEnvelope{}, time.Now())
+	if !errors.Is(err, ErrUnknownEvent) {
+		t.Errorf("err = %v, want ErrUnknownEvent", err)
+	}
+}
+
+func TestDecodeEventUnknownType(t *testing.T) {
+	if _, err := DecodeEvent(&pb.EventEnvelope{Type: "events.NoSuchEvent"}); err == nil {
+		t.Error("DecodeEvent of an unregistered type succeeded")
+	}
+}
+
+// envelopeMessage publishes event through a recording publisher and returns the message.
+func envelopeMessage(t *testing.T, event proto.Message) Message {
+	t.Helper()
+	var rec recordingPublisher
+	if err := PublishEvent(context.Background(), &rec, TopicChatEvents, "chat-1", "test", event); err != nil {
+		t.Fatalf("PublishEvent: %v", err)
+	}
+	return rec.msgs[0]
+}
+
+type recordingPublisher struct{ msgs []Message }
+
+func (p *recordingPublisher) Publish(_ context.Context, msg Message) error {
+	p.msgs = append(p.msgs, msg)
+	return nil
+}
+
+func TestPublishEventHeaders(t *testing.T) {
+	msg := envelopeMessage(t, &pb.ReceiptUpdated{ChatId: "chat-1"})
+	if msg.Topic != TopicChatEvents || msg.Key != "chat-1" {
+		t.Errorf("topic %q key %q", msg.Topic, msg.Key)
+	}
+	var env pb.EventEnvelope
+	if err := proto.Unmarshal(msg.Value, &env); err != nil {
+		t.Fatalf("value is not an envelope: %v", err)
+	}
+	want := map[string]string{
+		HeaderContentType:  ContentTypeEnvelope,
+		HeaderEventID:      env.EventId,
+		HeaderEventType:    "events.ReceiptUpdated",
+		HeaderEventVersion: strconv.Itoa(int(env.Version)),
+	}
+	for k, v := range want {
+		if msg.Headers[k] != v {
+			t.Errorf("header %s = %q, want %q", k, msg.Headers[k], v)
+		}
+	}
+}
+
+func TestEventMux(t *testing.T) {
+	var sent []*pb.MessageSent
+	failing := errors.New("try again")
+	var receiptErr error
+
+	mux := NewEventMux()
+	HandleEvent(mux, func(_ context.Context, env *pb.EventEnvelope, ev *pb.MessageSent) error {
+		if env.Type != "events.MessageSent" {
+			t.Errorf("handler got envelope of %s", env.Type)
+		}
+		sent = append(sent, ev)
+		return nil
+	})
+	HandleEvent(mux, func(_ context.Context, _ *pb.EventEnvelope, _ *pb.ReceiptUpdated) error {
+		return receiptErr
+	})
+	ctx := context.Background()
+
+	t.Run("dispatches by type", func(t *testing.T) {
+		sent = nil
+		if err := mux.Handle(ctx, envelopeMessage(t, &pb.MessageSent{MessageId: 1})); err != nil {
+			t.Fatal(err)
+		}
+		if len(sent) != 1 || sent[0].MessageId != 1 {
+			t.Errorf("handled %v", sent)
+		}
+	})
+
+	t.Run("skips types without a handler", func(t *testing.T) {
+		sent = nil
+		if err := mux.Handle(ctx, envelopeMessage(t, &pb.ReactionChanged{MessageId: 1})); err != nil {
+			t.Fatal(err)
+		}
+		msg := envelopeMessage(t, &pb.ReactionChanged{MessageId: 1})
+		delete(msg.Headers, HeaderEventType) // decoded instead of skipped by header
+		if err := mux.Handle(ctx, msg); err != nil {
+			t.Fatal(err)
+		}
+		if len(sent) != 0 {
+			t.Errorf("handled %v", sent)
+		}
+	})
+
+	t.Run("skips newer versions", func(t *testing.T) {
+		sent = nil
+		env, err := NewEnvelope("test", &pb.MessageSent{MessageId: 2}, time.Now())
+		if err != nil {
+			t.Fatal(err)
+		}
+		env.Version = eventVersions["events.MessageSent"] + 1
+		data, _ := proto.Marshal(env)
+		if err := mux.Handle(ctx, Message{Topic: TopicChatEvents, Value: data}); err != nil {
+			t.Fatal(err)
+		}
+		if len(sent) != 0 {
+			t.Errorf("handled %v", sent)
+		}
+	})
+
+	t.Run("drops malformed messages", func(t *testing.T) {
+		if err := mux.Handle(ctx, Message{Topic: TopicChatEvents, Value: []byte{0xff, 0xff}}); err != nil {
+			t.Errorf("malformed envelope: err = %v, want nil", err)
+		}
+		env := &pb.EventEnvelope{Type: "events.MessageSent", Version: 1, Payload: []byte{0xff, 0xff}}
+		data, _ := proto.Marshal(env)
+		if err := mux.Handle(ctx, Message{Topic: TopicChatEvents, Value: data}); err != nil {
+			t.Errorf("malformed payload: err = %v, want nil", err)
+		}
+	})
+
+	t.Run("returns handler errors for retry", func(t *testing.T) {
+		receiptErr = failing
+		defer func() { receiptErr = nil }()
+		if err := mux.Handle(ctx, envelopeMessage(t, &pb.ReceiptUpdated{})); !errors.Is(err, failing) {
+			t.Errorf("err = %v, want %v", err, failing)
+		}
+	})
+}
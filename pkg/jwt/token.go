@@ -32,6 +32,8 @@ const (
 // UserID artık 'sub' (Subject) standardı içinde taşınacak.
 type CustomClaims struct {
 	Type TokenType `json:"type"` // 'access' veya 'refresh'
+	// DeviceID, token'ın ait olduğu cihaz oturumu (user_devices.id).
+	DeviceID string `json:"device_id,omitempty"`
 	jwt.RegisteredClaims
 }
 
@@ -63,13 +65,14 @@ func (tm *TokenManager) generateToken(claims CustomClaims) (string, error) {
 
 // --- GÜNCELLENDİ: GenerateTokens ---
 // Bir kullanıcı ID'si için standart claim'leri (sub, jti, iat vb.) içeren
-// yeni bir access ve refresh token çifti oluşturur.
-func (tm *TokenManager) GenerateTokens(userID string) (string, string, error) {
+// yeni bir access ve refresh token çifti oluşturur. İki token da deviceID cihazına bağlanır.
+func (tm *TokenManager) GenerateTokens(userID string, deviceID string) (string, string, error) {
 	now := time.Now()
 
 	// Access Token Claims
 	accessClaims := CustomClaims{
-		Type: TokenTypeAccess,
+		Type:     TokenTypeAccess,
+		DeviceID: deviceID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			// 'sub' (Subject) standardını UserID için kullanıyoruz
 			Subject: userID,
@@ -91,7 +94,8 @@ func (tm *TokenManager) GenerateTokens(userID string) (string, string, error) {
 
 	// Refresh Token Claims
 	refreshClaims := CustomClaims{
-		Type: TokenTypeRefresh,
+		Type:     TokenTypeRefresh,
+		DeviceID: deviceID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			Subject: userID,
 			ID:      uuid.NewString(), // Refresh token için de benzersiz ID
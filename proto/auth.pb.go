@@ -649,6 +649,995 @@ func (x *RevokeResponse) GetSuccess() bool {
 	return false
 }
 
+type GetUserProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserProfileRequest) Reset() {
+	*x = GetUserProfileRequest{}
+	mi := &file_proto_auth_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserProfileRequest) ProtoMessage() {}
+
+func (x *GetUserProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetUserProfileRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetUserProfileRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetUserProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserProfileResponse) Reset() {
+	*x = GetUserProfileResponse{}
+	mi := &file_proto_auth_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserProfileResponse) ProtoMessage() {}
+
+func (x *GetUserProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserProfileResponse.ProtoReflect.Descriptor instead.
+func (*GetUserProfileResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetUserProfileResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type UpdateUserProfileRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only the fields that are set are changed
+	DisplayName       *string `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3,oneof" json:"display_name,omitempty"`
+	ProfilePictureUrl *string `protobuf:"bytes,2,opt,name=profile_picture_url,json=profilePictureUrl,proto3,oneof" json:"profile_picture_url,omitempty"`
+	AboutText         *string `protobuf:"bytes,3,opt,name=about_text,json=aboutText,proto3,oneof" json:"about_text,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UpdateUserProfileRequest) Reset() {
+	*x = UpdateUserProfileRequest{}
+	mi := &file_proto_auth_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserProfileRequest) ProtoMessage() {}
+
+func (x *UpdateUserProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserProfileRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateUserProfileRequest) GetDisplayName() string {
+	if x != nil && x.DisplayName != nil {
+		return *x.DisplayName
+	}
+	return ""
+}
+
+func (x *UpdateUserProfileRequest) GetProfilePictureUrl() string {
+	if x != nil && x.ProfilePictureUrl != nil {
+		return *x.ProfilePictureUrl
+	}
+	return ""
+}
+
+func (x *UpdateUserProfileRequest) GetAboutText() string {
+	if x != nil && x.AboutText != nil {
+		return *x.AboutText
+	}
+	return ""
+}
+
+type UpdateUserProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserProfileResponse) Reset() {
+	*x = UpdateUserProfileResponse{}
+	mi := &file_proto_auth_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserProfileResponse) ProtoMessage() {}
+
+func (x *UpdateUserProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserProfileResponse.ProtoReflect.Descriptor instead.
+func (*UpdateUserProfileResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdateUserProfileResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type BlockUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockUserRequest) Reset() {
+	*x = BlockUserRequest{}
+	mi := &file_proto_auth_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserRequest) ProtoMessage() {}
+
+func (x *BlockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserRequest.ProtoReflect.Descriptor instead.
+func (*BlockUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BlockUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type BlockUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockUserResponse) Reset() {
+	*x = BlockUserResponse{}
+	mi := &file_proto_auth_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserResponse) ProtoMessage() {}
+
+func (x *BlockUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserResponse.ProtoReflect.Descriptor instead.
+func (*BlockUserResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BlockUserResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListBlockedUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlockedUsersRequest) Reset() {
+	*x = ListBlockedUsersRequest{}
+	mi := &file_proto_auth_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlockedUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlockedUsersRequest) ProtoMessage() {}
+
+func (x *ListBlockedUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlockedUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListBlockedUsersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{18}
+}
+
+type BlockedUser struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BlockedAt     string                 `protobuf:"bytes,2,opt,name=blocked_at,json=blockedAt,proto3" json:"blocked_at,omitempty"` // RFC3339
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockedUser) Reset() {
+	*x = BlockedUser{}
+	mi := &file_proto_auth_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockedUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockedUser) ProtoMessage() {}
+
+func (x *BlockedUser) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockedUser.ProtoReflect.Descriptor instead.
+func (*BlockedUser) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *BlockedUser) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BlockedUser) GetBlockedAt() string {
+	if x != nil {
+		return x.BlockedAt
+	}
+	return ""
+}
+
+type ListBlockedUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Blocked       []*BlockedUser         `protobuf:"bytes,1,rep,name=blocked,proto3" json:"blocked,omitempty"` // most recently blocked first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlockedUsersResponse) Reset() {
+	*x = ListBlockedUsersResponse{}
+	mi := &file_proto_auth_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlockedUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlockedUsersResponse) ProtoMessage() {}
+
+func (x *ListBlockedUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlockedUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListBlockedUsersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListBlockedUsersResponse) GetBlocked() []*BlockedUser {
+	if x != nil {
+		return x.Blocked
+	}
+	return nil
+}
+
+type ContactEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PhoneNumber   string                 `protobuf:"bytes,1,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"` // as written in the address book
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"` // stored as the caller's name for this contact
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContactEntry) Reset() {
+	*x = ContactEntry{}
+	mi := &file_proto_auth_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContactEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContactEntry) ProtoMessage() {}
+
+func (x *ContactEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContactEntry.ProtoReflect.Descriptor instead.
+func (*ContactEntry) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ContactEntry) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *ContactEntry) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+type SyncContactsRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Contacts            []*ContactEntry        `protobuf:"bytes,1,rep,name=contacts,proto3" json:"contacts,omitempty"`                                                    // added or changed entries (at most 5000 with removed)
+	RemovedPhoneNumbers []string               `protobuf:"bytes,2,rep,name=removed_phone_numbers,json=removedPhoneNumbers,proto3" json:"removed_phone_numbers,omitempty"` // delta mode only
+	ReplaceAll          bool                   `protobuf:"varint,3,opt,name=replace_all,json=replaceAll,proto3" json:"replace_all,omitempty"`                             // contacts is the whole address book; others are removed
+	DefaultCountryCode  string                 `protobuf:"bytes,4,opt,name=default_country_code,json=defaultCountryCode,proto3" json:"default_country_code,omitempty"`    // e.g. "90", for numbers without an international prefix
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SyncContactsRequest) Reset() {
+	*x = SyncContactsRequest{}
+	mi := &file_proto_auth_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncContactsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncContactsRequest) ProtoMessage() {}
+
+func (x *SyncContactsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncContactsRequest.ProtoReflect.Descriptor instead.
+func (*SyncContactsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SyncContactsRequest) GetContacts() []*ContactEntry {
+	if x != nil {
+		return x.Contacts
+	}
+	return nil
+}
+
+func (x *SyncContactsRequest) GetRemovedPhoneNumbers() []string {
+	if x != nil {
+		return x.RemovedPhoneNumbers
+	}
+	return nil
+}
+
+func (x *SyncContactsRequest) GetReplaceAll() bool {
+	if x != nil {
+		return x.ReplaceAll
+	}
+	return false
+}
+
+func (x *SyncContactsRequest) GetDefaultCountryCode() string {
+	if x != nil {
+		return x.DefaultCountryCode
+	}
+	return ""
+}
+
+type RegisteredContact struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PhoneNumber   string                 `protobuf:"bytes,1,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"` // E.164
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"` // the caller's name for this contact
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisteredContact) Reset() {
+	*x = RegisteredContact{}
+	mi := &file_proto_auth_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisteredContact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisteredContact) ProtoMessage() {}
+
+func (x *RegisteredContact) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisteredContact.ProtoReflect.Descriptor instead.
+func (*RegisteredContact) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RegisteredContact) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *RegisteredContact) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RegisteredContact) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+type SyncContactsResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Registered          []*RegisteredContact   `protobuf:"bytes,1,rep,name=registered,proto3" json:"registered,omitempty"`                                                // all of the caller's contacts that are registered users
+	InvalidPhoneNumbers []string               `protobuf:"bytes,2,rep,name=invalid_phone_numbers,json=invalidPhoneNumbers,proto3" json:"invalid_phone_numbers,omitempty"` // entries that could not be normalized and were skipped
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SyncContactsResponse) Reset() {
+	*x = SyncContactsResponse{}
+	mi := &file_proto_auth_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncContactsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncContactsResponse) ProtoMessage() {}
+
+func (x *SyncContactsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncContactsResponse.ProtoReflect.Descriptor instead.
+func (*SyncContactsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SyncContactsResponse) GetRegistered() []*RegisteredContact {
+	if x != nil {
+		return x.Registered
+	}
+	return nil
+}
+
+func (x *SyncContactsResponse) GetInvalidPhoneNumbers() []string {
+	if x != nil {
+		return x.InvalidPhoneNumbers
+	}
+	return nil
+}
+
+// Audiences are "everyone", "contacts", "contacts_except" (contacts minus the setting's
+// exceptions) or "nobody".
+type PrivacySettings struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LastSeen      string                 `protobuf:"bytes,1,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	Online        string                 `protobuf:"bytes,2,opt,name=online,proto3" json:"online,omitempty"`
+	ProfilePhoto  string                 `protobuf:"bytes,3,opt,name=profile_photo,json=profilePhoto,proto3" json:"profile_photo,omitempty"`
+	About         string                 `protobuf:"bytes,4,opt,name=about,proto3" json:"about,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	GroupAdd      string                 `protobuf:"bytes,6,opt,name=group_add,json=groupAdd,proto3" json:"group_add,omitempty"`
+	Exceptions    []*PrivacyException    `protobuf:"bytes,7,rep,name=exceptions,proto3" json:"exceptions,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ReadReceipts  bool                   `protobuf:"varint,9,opt,name=read_receipts,json=readReceipts,proto3" json:"read_receipts,omitempty"` // false hides the user's status views from posters
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrivacySettings) Reset() {
+	*x = PrivacySettings{}
+	mi := &file_proto_auth_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrivacySettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrivacySettings) ProtoMessage() {}
+
+func (x *PrivacySettings) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrivacySettings.ProtoReflect.Descriptor instead.
+func (*PrivacySettings) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PrivacySettings) GetLastSeen() string {
+	if x != nil {
+		return x.LastSeen
+	}
+	return ""
+}
+
+func (x *PrivacySettings) GetOnline() string {
+	if x != nil {
+		return x.Online
+	}
+	return ""
+}
+
+func (x *PrivacySettings) GetProfilePhoto() string {
+	if x != nil {
+		return x.ProfilePhoto
+	}
+	return ""
+}
+
+func (x *PrivacySettings) GetAbout() string {
+	if x != nil {
+		return x.About
+	}
+	return ""
+}
+
+func (x *PrivacySettings) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PrivacySettings) GetGroupAdd() string {
+	if x != nil {
+		return x.GroupAdd
+	}
+	return ""
+}
+
+func (x *PrivacySettings) GetExceptions() []*PrivacyException {
+	if x != nil {
+		return x.Exceptions
+	}
+	return nil
+}
+
+func (x *PrivacySettings) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *PrivacySettings) GetReadReceipts() bool {
+	if x != nil {
+		return x.ReadReceipts
+	}
+	return false
+}
+
+// Contacts excluded from a "contacts_except" setting
+type PrivacyException struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Setting       string                 `protobuf:"bytes,1,opt,name=setting,proto3" json:"setting,omitempty"` // "last_seen", "online", "profile_photo", "about", "status" or "group_add"
+	UserIds       []string               `protobuf:"bytes,2,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrivacyException) Reset() {
+	*x = PrivacyException{}
+	mi := &file_proto_auth_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrivacyException) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrivacyException) ProtoMessage() {}
+
+func (x *PrivacyException) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrivacyException.ProtoReflect.Descriptor instead.
+func (*PrivacyException) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PrivacyException) GetSetting() string {
+	if x != nil {
+		return x.Setting
+	}
+	return ""
+}
+
+func (x *PrivacyException) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type GetPrivacySettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPrivacySettingsRequest) Reset() {
+	*x = GetPrivacySettingsRequest{}
+	mi := &file_proto_auth_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPrivacySettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrivacySettingsRequest) ProtoMessage() {}
+
+func (x *GetPrivacySettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrivacySettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetPrivacySettingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{27}
+}
+
+// Empty audiences keep their current value; each exception entry replaces that setting's list.
+type UpdatePrivacySettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LastSeen      string                 `protobuf:"bytes,1,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	Online        string                 `protobuf:"bytes,2,opt,name=online,proto3" json:"online,omitempty"`
+	ProfilePhoto  string                 `protobuf:"bytes,3,opt,name=profile_photo,json=profilePhoto,proto3" json:"profile_photo,omitempty"`
+	About         string                 `protobuf:"bytes,4,opt,name=about,proto3" json:"about,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	GroupAdd      string                 `protobuf:"bytes,6,opt,name=group_add,json=groupAdd,proto3" json:"group_add,omitempty"`
+	Exceptions    []*PrivacyException    `protobuf:"bytes,7,rep,name=exceptions,proto3" json:"exceptions,omitempty"`
+	ReadReceipts  *bool                  `protobuf:"varint,8,opt,name=read_receipts,json=readReceipts,proto3,oneof" json:"read_receipts,omitempty"` // unset keeps the current value
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePrivacySettingsRequest) Reset() {
+	*x = UpdatePrivacySettingsRequest{}
+	mi := &file_proto_auth_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePrivacySettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePrivacySettingsRequest) ProtoMessage() {}
+
+func (x *UpdatePrivacySettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePrivacySettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePrivacySettingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UpdatePrivacySettingsRequest) GetLastSeen() string {
+	if x != nil {
+		return x.LastSeen
+	}
+	return ""
+}
+
+func (x *UpdatePrivacySettingsRequest) GetOnline() string {
+	if x != nil {
+		return x.Online
+	}
+	return ""
+}
+
+func (x *UpdatePrivacySettingsRequest) GetProfilePhoto() string {
+	if x != nil {
+		return x.ProfilePhoto
+	}
+	return ""
+}
+
+func (x *UpdatePrivacySettingsRequest) GetAbout() string {
+	if x != nil {
+		return x.About
+	}
+	return ""
+}
+
+func (x *UpdatePrivacySettingsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UpdatePrivacySettingsRequest) GetGroupAdd() string {
+	if x != nil {
+		return x.GroupAdd
+	}
+	return ""
+}
+
+func (x *UpdatePrivacySettingsRequest) GetExceptions() []*PrivacyException {
+	if x != nil {
+		return x.Exceptions
+	}
+	return nil
+}
+
+func (x *UpdatePrivacySettingsRequest) GetReadReceipts() bool {
+	if x != nil && x.ReadReceipts != nil {
+		return *x.ReadReceipts
+	}
+	return false
+}
+
+type PrivacySettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *PrivacySettings       `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrivacySettingsResponse) Reset() {
+	*x = PrivacySettingsResponse{}
+	mi := &file_proto_auth_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrivacySettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrivacySettingsResponse) ProtoMessage() {}
+
+func (x *PrivacySettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrivacySettingsResponse.ProtoReflect.Descriptor instead.
+func (*PrivacySettingsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PrivacySettingsResponse) GetSettings() *PrivacySettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
 var File_proto_auth_proto protoreflect.FileDescriptor
 
 const file_proto_auth_proto_rawDesc = "" +
@@ -694,14 +1683,99 @@ const file_proto_auth_proto_rawDesc = "" +
 	"\x17LogoutAllDevicesRequest\x12!\n" +
 	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\"*\n" +
 	"\x0eRevokeResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess2\xac\x03\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"0\n" +
+	"\x15GetUserProfileRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"8\n" +
+	"\x16GetUserProfileResponse\x12\x1e\n" +
+	"\x04user\x18\x01 \x01(\v2\n" +
+	".auth.UserR\x04user\"\xd3\x01\n" +
+	"\x18UpdateUserProfileRequest\x12&\n" +
+	"\fdisplay_name\x18\x01 \x01(\tH\x00R\vdisplayName\x88\x01\x01\x123\n" +
+	"\x13profile_picture_url\x18\x02 \x01(\tH\x01R\x11profilePictureUrl\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"about_text\x18\x03 \x01(\tH\x02R\taboutText\x88\x01\x01B\x0f\n" +
+	"\r_display_nameB\x16\n" +
+	"\x14_profile_picture_urlB\r\n" +
+	"\v_about_text\";\n" +
+	"\x19UpdateUserProfileResponse\x12\x1e\n" +
+	"\x04user\x18\x01 \x01(\v2\n" +
+	".auth.UserR\x04user\"+\n" +
+	"\x10BlockUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"-\n" +
+	"\x11BlockUserResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x19\n" +
+	"\x17ListBlockedUsersRequest\"E\n" +
+	"\vBlockedUser\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"blocked_at\x18\x02 \x01(\tR\tblockedAt\"G\n" +
+	"\x18ListBlockedUsersResponse\x12+\n" +
+	"\ablocked\x18\x01 \x03(\v2\x11.auth.BlockedUserR\ablocked\"T\n" +
+	"\fContactEntry\x12!\n" +
+	"\fphone_number\x18\x01 \x01(\tR\vphoneNumber\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\"\xcc\x01\n" +
+	"\x13SyncContactsRequest\x12.\n" +
+	"\bcontacts\x18\x01 \x03(\v2\x12.auth.ContactEntryR\bcontacts\x122\n" +
+	"\x15removed_phone_numbers\x18\x02 \x03(\tR\x13removedPhoneNumbers\x12\x1f\n" +
+	"\vreplace_all\x18\x03 \x01(\bR\n" +
+	"replaceAll\x120\n" +
+	"\x14default_country_code\x18\x04 \x01(\tR\x12defaultCountryCode\"r\n" +
+	"\x11RegisteredContact\x12!\n" +
+	"\fphone_number\x18\x01 \x01(\tR\vphoneNumber\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12!\n" +
+	"\fdisplay_name\x18\x03 \x01(\tR\vdisplayName\"\x83\x01\n" +
+	"\x14SyncContactsResponse\x127\n" +
+	"\n" +
+	"registered\x18\x01 \x03(\v2\x17.auth.RegisteredContactR\n" +
+	"registered\x122\n" +
+	"\x15invalid_phone_numbers\x18\x02 \x03(\tR\x13invalidPhoneNumbers\"\xb2\x02\n" +
+	"\x0fPrivacySettings\x12\x1b\n" +
+	"\tlast_seen\x18\x01 \x01(\tR\blastSeen\x12\x16\n" +
+	"\x06online\x18\x02 \x01(\tR\x06online\x12#\n" +
+	"\rprofile_photo\x18\x03 \x01(\tR\fprofilePhoto\x12\x14\n" +
+	"\x05about\x18\x04 \x01(\tR\x05about\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1b\n" +
+	"\tgroup_add\x18\x06 \x01(\tR\bgroupAdd\x126\n" +
+	"\n" +
+	"exceptions\x18\a \x03(\v2\x16.auth.PrivacyExceptionR\n" +
+	"exceptions\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\tR\tupdatedAt\x12#\n" +
+	"\rread_receipts\x18\t \x01(\bR\freadReceipts\"G\n" +
+	"\x10PrivacyException\x12\x18\n" +
+	"\asetting\x18\x01 \x01(\tR\asetting\x12\x19\n" +
+	"\buser_ids\x18\x02 \x03(\tR\auserIds\"\x1b\n" +
+	"\x19GetPrivacySettingsRequest\"\xb7\x02\n" +
+	"\x1cUpdatePrivacySettingsRequest\x12\x1b\n" +
+	"\tlast_seen\x18\x01 \x01(\tR\blastSeen\x12\x16\n" +
+	"\x06online\x18\x02 \x01(\tR\x06online\x12#\n" +
+	"\rprofile_photo\x18\x03 \x01(\tR\fprofilePhoto\x12\x14\n" +
+	"\x05about\x18\x04 \x01(\tR\x05about\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1b\n" +
+	"\tgroup_add\x18\x06 \x01(\tR\bgroupAdd\x126\n" +
+	"\n" +
+	"exceptions\x18\a \x03(\v2\x16.auth.PrivacyExceptionR\n" +
+	"exceptions\x12(\n" +
+	"\rread_receipts\x18\b \x01(\bH\x00R\freadReceipts\x88\x01\x01B\x10\n" +
+	"\x0e_read_receipts\"L\n" +
+	"\x17PrivacySettingsResponse\x121\n" +
+	"\bsettings\x18\x01 \x01(\v2\x15.auth.PrivacySettingsR\bsettings2\xac\x03\n" +
 	"\vAuthService\x126\n" +
 	"\aSendOTP\x12\x14.auth.SendOTPRequest\x1a\x15.auth.SendOTPResponse\x12<\n" +
 	"\tVerifyOTP\x12\x16.auth.VerifyOTPRequest\x1a\x17.auth.VerifyOTPResponse\x12H\n" +
 	"\rValidateToken\x12\x1a.auth.ValidateTokenRequest\x1a\x1b.auth.ValidateTokenResponse\x12E\n" +
 	"\fRefreshToken\x12\x19.auth.RefreshTokenRequest\x1a\x1a.auth.RefreshTokenResponse\x12M\n" +
 	"\x13RevokeCurrentDevice\x12 .auth.RevokeCurrentDeviceRequest\x1a\x14.auth.RevokeResponse\x12G\n" +
-	"\x10LogoutAllDevices\x12\x1d.auth.LogoutAllDevicesRequest\x1a\x14.auth.RevokeResponseB'Z%github.com/dykethecreator/GoApp/protob\x06proto3"
+	"\x10LogoutAllDevices\x12\x1d.auth.LogoutAllDevicesRequest\x1a\x14.auth.RevokeResponse2\xfa\x04\n" +
+	"\vUserService\x12K\n" +
+	"\x0eGetUserProfile\x12\x1b.auth.GetUserProfileRequest\x1a\x1c.auth.GetUserProfileResponse\x12T\n" +
+	"\x11UpdateUserProfile\x12\x1e.auth.UpdateUserProfileRequest\x1a\x1f.auth.UpdateUserProfileResponse\x12<\n" +
+	"\tBlockUser\x12\x16.auth.BlockUserRequest\x1a\x17.auth.BlockUserResponse\x12>\n" +
+	"\vUnblockUser\x12\x16.auth.BlockUserRequest\x1a\x17.auth.BlockUserResponse\x12Q\n" +
+	"\x10ListBlockedUsers\x12\x1d.auth.ListBlockedUsersRequest\x1a\x1e.auth.ListBlockedUsersResponse\x12E\n" +
+	"\fSyncContacts\x12\x19.auth.SyncContactsRequest\x1a\x1a.auth.SyncContactsResponse\x12T\n" +
+	"\x12GetPrivacySettings\x12\x1f.auth.GetPrivacySettingsRequest\x1a\x1d.auth.PrivacySettingsResponse\x12Z\n" +
+	"\x15UpdatePrivacySettings\x12\".auth.UpdatePrivacySettingsRequest\x1a\x1d.auth.PrivacySettingsResponseB'Z%github.com/dykethecreator/GoApp/protob\x06proto3"
 
 var (
 	file_proto_auth_proto_rawDescOnce sync.Once
@@ -715,40 +1789,82 @@ func file_proto_auth_proto_rawDescGZIP() []byte {
 	return file_proto_auth_proto_rawDescData
 }
 
-var file_proto_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_proto_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_proto_auth_proto_goTypes = []any{
-	(*User)(nil),                       // 0: auth.User
-	(*SendOTPRequest)(nil),             // 1: auth.SendOTPRequest
-	(*SendOTPResponse)(nil),            // 2: auth.SendOTPResponse
-	(*VerifyOTPRequest)(nil),           // 3: auth.VerifyOTPRequest
-	(*VerifyOTPResponse)(nil),          // 4: auth.VerifyOTPResponse
-	(*ValidateTokenRequest)(nil),       // 5: auth.ValidateTokenRequest
-	(*ValidateTokenResponse)(nil),      // 6: auth.ValidateTokenResponse
-	(*RefreshTokenRequest)(nil),        // 7: auth.RefreshTokenRequest
-	(*RefreshTokenResponse)(nil),       // 8: auth.RefreshTokenResponse
-	(*RevokeCurrentDeviceRequest)(nil), // 9: auth.RevokeCurrentDeviceRequest
-	(*LogoutAllDevicesRequest)(nil),    // 10: auth.LogoutAllDevicesRequest
-	(*RevokeResponse)(nil),             // 11: auth.RevokeResponse
+	(*User)(nil),                         // 0: auth.User
+	(*SendOTPRequest)(nil),               // 1: auth.SendOTPRequest
+	(*SendOTPResponse)(nil),              // 2: auth.SendOTPResponse
+	(*VerifyOTPRequest)(nil),             // 3: auth.VerifyOTPRequest
+	(*VerifyOTPResponse)(nil),            // 4: auth.VerifyOTPResponse
+	(*ValidateTokenRequest)(nil),         // 5: auth.ValidateTokenRequest
+	(*ValidateTokenResponse)(nil),        // 6: auth.ValidateTokenResponse
+	(*RefreshTokenRequest)(nil),          // 7: auth.RefreshTokenRequest
+	(*RefreshTokenResponse)(nil),         // 8: auth.RefreshTokenResponse
+	(*RevokeCurrentDeviceRequest)(nil),   // 9: auth.RevokeCurrentDeviceRequest
+	(*LogoutAllDevicesRequest)(nil),      // 10: auth.LogoutAllDevicesRequest
+	(*RevokeResponse)(nil),               // 11: auth.RevokeResponse
+	(*GetUserProfileRequest)(nil),        // 12: auth.GetUserProfileRequest
+	(*GetUserProfileResponse)(nil),       // 13: auth.GetUserProfileResponse
+	(*UpdateUserProfileRequest)(nil),     // 14: auth.UpdateUserProfileRequest
+	(*UpdateUserProfileResponse)(nil),    // 15: auth.UpdateUserProfileResponse
+	(*BlockUserRequest)(nil),             // 16: auth.BlockUserRequest
+	(*BlockUserResponse)(nil),            // 17: auth.BlockUserResponse
+	(*ListBlockedUsersRequest)(nil),      // 18: auth.ListBlockedUsersRequest
+	(*BlockedUser)(nil),                  // 19: auth.BlockedUser
+	(*ListBlockedUsersResponse)(nil),     // 20: auth.ListBlockedUsersResponse
+	(*ContactEntry)(nil),                 // 21: auth.ContactEntry
+	(*SyncContactsRequest)(nil),          // 22: auth.SyncContactsRequest
+	(*RegisteredContact)(nil),            // 23: auth.RegisteredContact
+	(*SyncContactsResponse)(nil),         // 24: auth.SyncContactsResponse
+	(*PrivacySettings)(nil),              // 25: auth.PrivacySettings
+	(*PrivacyException)(nil),             // 26: auth.PrivacyException
+	(*GetPrivacySettingsRequest)(nil),    // 27: auth.GetPrivacySettingsRequest
+	(*UpdatePrivacySettingsRequest)(nil), // 28: auth.UpdatePrivacySettingsRequest
+	(*PrivacySettingsResponse)(nil),      // 29: auth.PrivacySettingsResponse
 }
 var file_proto_auth_proto_depIdxs = []int32{
 	0,  // 0: auth.VerifyOTPResponse.user:type_name -> auth.User
-	1,  // 1: auth.AuthService.SendOTP:input_type -> auth.SendOTPRequest
-	3,  // 2: auth.AuthService.VerifyOTP:input_type -> auth.VerifyOTPRequest
-	5,  // 3: auth.AuthService.ValidateToken:input_type -> auth.ValidateTokenRequest
-	7,  // 4: auth.AuthService.RefreshToken:input_type -> auth.RefreshTokenRequest
-	9,  // 5: auth.AuthService.RevokeCurrentDevice:input_type -> auth.RevokeCurrentDeviceRequest
-	10, // 6: auth.AuthService.LogoutAllDevices:input_type -> auth.LogoutAllDevicesRequest
-	2,  // 7: auth.AuthService.SendOTP:output_type -> auth.SendOTPResponse
-	4,  // 8: auth.AuthService.VerifyOTP:output_type -> auth.VerifyOTPResponse
-	6,  // 9: auth.AuthService.ValidateToken:output_type -> auth.ValidateTokenResponse
-	8,  // 10: auth.AuthService.RefreshToken:output_type -> auth.RefreshTokenResponse
-	11, // 11: auth.AuthService.RevokeCurrentDevice:output_type -> auth.RevokeResponse
-	11, // 12: auth.AuthService.LogoutAllDevices:output_type -> auth.RevokeResponse
-	7,  // [7:13] is the sub-list for method output_type
-	1,  // [1:7] is the sub-list for method input_type
-	1,  // [1:1] is the sub-list for extension type_name
-	1,  // [1:1] is the sub-list for extension extendee
-	0,  // [0:1] is the sub-list for field type_name
+	0,  // 1: auth.GetUserProfileResponse.user:type_name -> auth.User
+	0,  // 2: auth.UpdateUserProfileResponse.user:type_name -> auth.User
+	19, // 3: auth.ListBlockedUsersResponse.blocked:type_name -> auth.BlockedUser
+	21, // 4: auth.SyncContactsRequest.contacts:type_name -> auth.ContactEntry
+	23, // 5: auth.SyncContactsResponse.registered:type_name -> auth.RegisteredContact
+	26, // 6: auth.PrivacySettings.exceptions:type_name -> auth.PrivacyException
+	26, // 7: auth.UpdatePrivacySettingsRequest.exceptions:type_name -> auth.PrivacyException
+	25, // 8: auth.PrivacySettingsResponse.settings:type_name -> auth.PrivacySettings
+	1,  // 9: auth.AuthService.SendOTP:input_type -> auth.SendOTPRequest
+	3,  // 10: auth.AuthService.VerifyOTP:input_type -> auth.VerifyOTPRequest
+	5,  // 11: auth.AuthService.ValidateToken:input_type -> auth.ValidateTokenRequest
+	7,  // 12: auth.AuthService.RefreshToken:input_type -> auth.RefreshTokenRequest
+	9,  // 13: auth.AuthService.RevokeCurrentDevice:input_type -> auth.RevokeCurrentDeviceRequest
+	10, // 14: auth.AuthService.LogoutAllDevices:input_type -> auth.LogoutAllDevicesRequest
+	12, // 15: auth.UserService.GetUserProfile:input_type -> auth.GetUserProfileRequest
+	14, // 16: auth.UserService.UpdateUserProfile:input_type -> auth.UpdateUserProfileRequest
+	16, // 17: auth.UserService.BlockUser:input_type -> auth.BlockUserRequest
+	16, // 18: auth.UserService.UnblockUser:input_type -> auth.BlockUserRequest
+	18, // 19: auth.UserService.ListBlockedUsers:input_type -> auth.ListBlockedUsersRequest
+	22, // 20: auth.UserService.SyncContacts:input_type -> auth.SyncContactsRequest
+	27, // 21: auth.UserService.GetPrivacySettings:input_type -> auth.GetPrivacySettingsRequest
+	28, // 22: auth.UserService.UpdatePrivacySettings:input_type -> auth.UpdatePrivacySettingsRequest
+	2,  // 23: auth.AuthService.SendOTP:output_type -> auth.SendOTPResponse
+	4,  // 24: auth.AuthService.VerifyOTP:output_type -> auth.VerifyOTPResponse
+	6,  // 25: auth.AuthService.ValidateToken:output_type -> auth.ValidateTokenResponse
+	8,  // 26: auth.AuthService.RefreshToken:output_type -> auth.RefreshTokenResponse
+	11, // 27: auth.AuthService.RevokeCurrentDevice:output_type -> auth.RevokeResponse
+	11, // 28: auth.AuthService.LogoutAllDevices:output_type -> auth.RevokeResponse
+	13, // 29: auth.UserService.GetUserProfile:output_type -> auth.GetUserProfileResponse
+	15, // 30: auth.UserService.UpdateUserProfile:output_type -> auth.UpdateUserProfileResponse
+	17, // 31: auth.UserService.BlockUser:output_type -> auth.BlockUserResponse
+	17, // 32: auth.UserService.UnblockUser:output_type -> auth.BlockUserResponse
+	20, // 33: auth.UserService.ListBlockedUsers:output_type -> auth.ListBlockedUsersResponse
+	24, // 34: auth.UserService.SyncContacts:output_type -> auth.SyncContactsResponse
+	29, // 35: auth.UserService.GetPrivacySettings:output_type -> auth.PrivacySettingsResponse
+	29, // 36: auth.UserService.UpdatePrivacySettings:output_type -> auth.PrivacySettingsResponse
+	23, // [23:37] is the sub-list for method output_type
+	9,  // [9:23] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_proto_auth_proto_init() }
@@ -756,15 +1872,17 @@ func file_proto_auth_proto_init() {
 	if File_proto_auth_proto != nil {
 		return
 	}
+	file_proto_auth_proto_msgTypes[14].OneofWrappers = []any{}
+	file_proto_auth_proto_msgTypes[28].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_auth_proto_rawDesc), len(file_proto_auth_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   30,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_proto_auth_proto_goTypes,
 		DependencyIndexes: file_proto_auth_proto_depIdxs,